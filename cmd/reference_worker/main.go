@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sparallel_server/internal/reference_worker"
+	"strings"
+)
+
+func main() {
+	handshake := flag.Bool("handshake", false, "Send a handshake frame on start")
+	tags := flag.String("tags", "", "Comma separated tags of the handshake")
+
+	flag.Parse()
+
+	var handshakeTags []string
+
+	if *handshake {
+		handshakeTags = []string{}
+
+		if *tags != "" {
+			handshakeTags = strings.Split(*tags, ",")
+		}
+	}
+
+	err := reference_worker.Run(os.Stdin, os.Stdout, handshakeTags)
+
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err.Error())
+
+		os.Exit(1)
+	}
+}
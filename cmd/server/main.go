@@ -8,6 +8,7 @@ import (
 	"slices"
 	"sparallel_server/internal/commands"
 	"sparallel_server/internal/config"
+	"sparallel_server/internal/providers"
 	"sparallel_server/pkg/foundation/app"
 	appConfig "sparallel_server/pkg/foundation/config"
 	"strconv"
@@ -105,5 +106,7 @@ func getLogLevels() []slog.Level {
 }
 
 func getServiceProviders() []app.ServiceProviderInterface {
-	return []app.ServiceProviderInterface{}
+	return []app.ServiceProviderInterface{
+		providers.NewCoreProvider(),
+	}
 }
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"sparallel_server/pkg/foundation/errs"
+)
+
+// AuthenticateMethod is always allowed, it authenticates goridge connections
+const AuthenticateMethod = "AuthServer.Authenticate"
+
+const anonymousName = "anonymous"
+
+// The errors of the calls are not wrapped by errs.Err, clients get them as they are
+var (
+	ErrUnauthenticated = errors.New("authentication failed")
+	ErrDenied          = errors.New("access denied")
+)
+
+// ACL maps the tokens to the allowed calls. It is loaded from a JSON file like
+//
+//	{
+//	  "Tokens": [
+//	    {"Name": "app", "Token": "secret", "Methods": ["WorkersServer.*", "ProxyMongodbServer.*"], "Mongodb": ["default/app"]}
+//	  ],
+//	  "Anonymous": {"Methods": ["PingPongServer.Ping"]}
+//	}
+type ACL struct {
+	Tokens    []*Credential
+	Anonymous *Credential // rules of not authenticated calls, nil - they are denied
+}
+
+type Credential struct {
+	Name    string
+	Token   string
+	Methods []string // path.Match patterns of Service.Method like ManagerServer.*
+	Mongodb []string // path.Match patterns of connection/database like default/*
+}
+
+type MongodbTarget interface {
+	GetConnection() string
+	GetDatabase() string
+}
+
+func LoadACL(filePath string) (*ACL, error) {
+	data, err := os.ReadFile(filePath)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	acl := &ACL{}
+
+	if err = json.Unmarshal(data, acl); err != nil {
+		return nil, errs.Err(errors.New("invalid acl file [" + filePath + "]: " + err.Error()))
+	}
+
+	credentials := acl.Tokens
+
+	if acl.Anonymous != nil {
+		acl.Anonymous.Name = anonymousName
+		acl.Anonymous.Token = ""
+
+		credentials = append(credentials, acl.Anonymous)
+	}
+
+	for _, credential := range credentials {
+		if credential.Name == "" || (credential.Token == "" && credential != acl.Anonymous) {
+			return nil, errs.Err(errors.New("acl tokens require a name and a token"))
+		}
+
+		for _, pattern := range append(credential.Methods, credential.Mongodb...) {
+			if _, err = path.Match(pattern, ""); err != nil {
+				return nil, errs.Err(errors.New("invalid acl pattern [" + pattern + "] of [" + credential.Name + "]"))
+			}
+		}
+	}
+
+	return acl, nil
+}
+
+func (a *ACL) Authenticate(token string) *Credential {
+	if token == "" {
+		return nil
+	}
+
+	sum := sha256.Sum256([]byte(token))
+
+	var found *Credential
+
+	// all the tokens are compared to take the same time
+	for _, credential := range a.Tokens {
+		credentialSum := sha256.Sum256([]byte(credential.Token))
+
+		if subtle.ConstantTimeCompare(sum[:], credentialSum[:]) == 1 {
+			found = credential
+		}
+	}
+
+	if found == nil {
+		slog.Warn("Authentication failed with an unknown token")
+	}
+
+	return found
+}
+
+func (a *ACL) Authorize(credential *Credential, serviceMethod string, args any) error {
+	if serviceMethod == AuthenticateMethod {
+		return nil
+	}
+
+	if credential == nil {
+		credential = a.Anonymous
+	}
+
+	if credential == nil {
+		return deny(ErrUnauthenticated, anonymousName, serviceMethod, "authenticate by "+AuthenticateMethod+" first")
+	}
+
+	if !matchAny(credential.Methods, serviceMethod) {
+		return deny(ErrDenied, credential.Name, serviceMethod, "the method is not allowed")
+	}
+
+	if target, ok := args.(MongodbTarget); ok {
+		database := target.GetConnection() + "/" + target.GetDatabase()
+
+		if !matchAny(credential.Mongodb, database) {
+			return deny(ErrDenied, credential.Name, serviceMethod, "mongodb ["+database+"] is not allowed")
+		}
+	}
+
+	return nil
+}
+
+func deny(reason error, name string, serviceMethod string, message string) error {
+	slog.Warn("Denied [" + serviceMethod + "] of [" + name + "]: " + message)
+
+	return fmt.Errorf("%w: [%s] of [%s]: %s", reason, serviceMethod, name, message)
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+
+	return false
+}
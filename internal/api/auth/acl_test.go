@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TestMongodbArgs struct {
+	Connection string
+	Database   string
+}
+
+func (a *TestMongodbArgs) GetConnection() string {
+	return a.Connection
+}
+
+func (a *TestMongodbArgs) GetDatabase() string {
+	return a.Database
+}
+
+func TestLoadACL(t *testing.T) {
+	acl := writeTestACL(t, `{
+		"Tokens": [
+			{"Name": "app", "Token": "app-token", "Methods": ["WorkersServer.*", "ProxyMongodbServer.*"], "Mongodb": ["default/app*"]},
+			{"Name": "admin", "Token": "admin-token", "Methods": ["*"], "Mongodb": ["*/*"]}
+		],
+		"Anonymous": {"Methods": ["PingPongServer.Ping"]}
+	}`)
+
+	assert.Len(t, acl.Tokens, 2)
+	assert.Equal(t, anonymousName, acl.Anonymous.Name)
+
+	for _, content := range []string{
+		`{`,
+		`{"Tokens": [{"Name": "app"}]}`,
+		`{"Tokens": [{"Name": "app", "Token": "t", "Methods": ["["]}]}`,
+	} {
+		path := filepath.Join(t.TempDir(), "acl.json")
+
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+		_, err := LoadACL(path)
+
+		assert.Error(t, err, content)
+	}
+}
+
+func TestACL_Authorize(t *testing.T) {
+	acl := writeTestACL(t, `{
+		"Tokens": [
+			{"Name": "app", "Token": "app-token", "Methods": ["WorkersServer.*", "ProxyMongodbServer.*"], "Mongodb": ["default/app*"]}
+		],
+		"Anonymous": {"Methods": ["PingPongServer.Ping"]}
+	}`)
+
+	assert.Nil(t, acl.Authenticate("unknown"))
+	assert.Nil(t, acl.Authenticate(""))
+
+	app := acl.Authenticate("app-token")
+
+	require.NotNil(t, app)
+	assert.Equal(t, "app", app.Name)
+
+	assert.NoError(t, acl.Authorize(app, "WorkersServer.AddTask", nil))
+	assert.NoError(t, acl.Authorize(app, "ProxyMongodbServer.InsertOne", &TestMongodbArgs{"default", "app_db"}))
+	assert.ErrorIs(t, acl.Authorize(app, "ProxyMongodbServer.InsertOne", &TestMongodbArgs{"default", "billing"}), ErrDenied)
+	assert.ErrorIs(t, acl.Authorize(app, "ManagerServer.Stop", nil), ErrDenied)
+
+	assert.NoError(t, acl.Authorize(nil, "PingPongServer.Ping", nil))
+	assert.NoError(t, acl.Authorize(nil, AuthenticateMethod, nil))
+	assert.ErrorIs(t, acl.Authorize(nil, "WorkersServer.Reload", nil), ErrDenied)
+
+	acl.Anonymous = nil
+
+	assert.ErrorIs(t, acl.Authorize(nil, "PingPongServer.Ping", nil), ErrUnauthenticated)
+}
+
+func writeTestACL(t *testing.T, content string) *ACL {
+	path := filepath.Join(t.TempDir(), "acl.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	acl, err := LoadACL(path)
+
+	require.NoError(t, err)
+
+	return acl
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"net/rpc"
+)
+
+type tokenArgs interface {
+	GetToken() string
+}
+
+type Codec struct {
+	rpc.ServerCodec
+
+	acl           *ACL
+	credential    *Credential
+	serviceMethod string
+}
+
+func NewCodec(codec rpc.ServerCodec, acl *ACL) *Codec {
+	return &Codec{
+		ServerCodec: codec,
+		acl:         acl,
+	}
+}
+
+func (c *Codec) ReadRequestHeader(request *rpc.Request) error {
+	err := c.ServerCodec.ReadRequestHeader(request)
+
+	c.serviceMethod = request.ServiceMethod
+
+	return err
+}
+
+// ReadRequestBody decodes the arguments first, the mongodb targets are known after that
+func (c *Codec) ReadRequestBody(args any) error {
+	err := c.ServerCodec.ReadRequestBody(args)
+
+	// nil arguments are read for not found methods
+	if err != nil || args == nil {
+		return err
+	}
+
+	if c.serviceMethod != AuthenticateMethod {
+		return c.acl.Authorize(c.credential, c.serviceMethod, args)
+	}
+
+	token, ok := args.(tokenArgs)
+
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	c.credential = c.acl.Authenticate(token.GetToken())
+
+	if c.credential == nil {
+		return ErrUnauthenticated
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"net"
+	"net/rpc"
+	"testing"
+
+	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AuthServer struct{}
+
+type TestServer struct{}
+
+type TestTokenArgs struct {
+	Token string
+}
+
+func (a *TestTokenArgs) GetToken() string {
+	return a.Token
+}
+
+type TestReply struct {
+	Answer string
+}
+
+func (s *AuthServer) Authenticate(_ *TestTokenArgs, reply *TestReply) error {
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func (s *TestServer) Call(_ *TestMongodbArgs, reply *TestReply) error {
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func TestCodec(t *testing.T) {
+	acl := writeTestACL(t, `{
+		"Tokens": [
+			{"Name": "app", "Token": "app-token", "Methods": ["TestServer.*"], "Mongodb": ["default/app"]}
+		]
+	}`)
+
+	server := rpc.NewServer()
+
+	require.NoError(t, server.Register(&AuthServer{}))
+	require.NoError(t, server.Register(&TestServer{}))
+
+	serverConn, clientConn := net.Pipe()
+
+	go server.ServeCodec(NewCodec(goridgeRpc.NewCodec(serverConn), acl))
+
+	client := rpc.NewClientWithCodec(goridgeRpc.NewClientCodec(clientConn))
+
+	defer client.Close()
+
+	var reply TestReply
+
+	allowed := TestMongodbArgs{Connection: "default", Database: "app"}
+
+	err := client.Call("TestServer.Call", allowed, &reply)
+
+	assert.ErrorContains(t, err, ErrUnauthenticated.Error())
+
+	err = client.Call(AuthenticateMethod, TestTokenArgs{Token: "wrong"}, &reply)
+
+	assert.ErrorContains(t, err, ErrUnauthenticated.Error())
+
+	require.NoError(t, client.Call(AuthenticateMethod, TestTokenArgs{Token: "app-token"}, &reply))
+
+	require.NoError(t, client.Call("TestServer.Call", allowed, &reply))
+	assert.Equal(t, "Ok", reply.Answer)
+
+	err = client.Call("TestServer.Call", TestMongodbArgs{Connection: "default", Database: "other"}, &reply)
+
+	assert.ErrorContains(t, err, ErrDenied.Error())
+
+	// the connection keeps working after denied calls
+	require.NoError(t, client.Call("TestServer.Call", allowed, &reply))
+}
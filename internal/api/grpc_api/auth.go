@@ -0,0 +1,114 @@
+package grpc_api
+
+import (
+	"context"
+	"errors"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// rpcServiceNames maps the gRPC services to the goridge ones, the acl patterns are shared
+var rpcServiceNames = map[string]string{
+	pb.WorkersService_ServiceDesc.ServiceName:      "WorkersServer",
+	pb.ManagerService_ServiceDesc.ServiceName:      "ManagerServer",
+	pb.MongodbProxyService_ServiceDesc.ServiceName: "ProxyMongodbServer",
+}
+
+type authorizer struct {
+	acl *auth.ACL
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+
+	authorize func(request any) error
+}
+
+func (a *authorizer) unary(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	authorize, err := a.prepare(ctx, info.FullMethod)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorize(request); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+func (a *authorizer) stream(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	authorize, err := a.prepare(stream.Context(), info.FullMethod)
+
+	if err != nil {
+		return err
+	}
+
+	return handler(server, &authorizedStream{ServerStream: stream, authorize: authorize})
+}
+
+// RecvMsg authorizes the request, the mongodb targets are known after reading it
+func (s *authorizedStream) RecvMsg(request any) error {
+	if err := s.ServerStream.RecvMsg(request); err != nil {
+		return err
+	}
+
+	return s.authorize(request)
+}
+
+func (a *authorizer) prepare(ctx context.Context, fullMethod string) (func(request any) error, error) {
+	var credential *auth.Credential
+
+	if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+		token, _ := strings.CutPrefix(values[0], "Bearer ")
+
+		credential = a.acl.Authenticate(token)
+
+		if credential == nil {
+			return nil, status.Error(codes.Unauthenticated, auth.ErrUnauthenticated.Error())
+		}
+	}
+
+	serviceMethod := toServiceMethod(fullMethod)
+
+	return func(request any) error {
+		err := a.acl.Authorize(credential, serviceMethod, request)
+
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, auth.ErrUnauthenticated):
+			return status.Error(codes.Unauthenticated, err.Error())
+		default:
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}, nil
+}
+
+// toServiceMethod converts /sparallel.v1.WorkersService/AddTask to WorkersServer.AddTask
+func toServiceMethod(fullMethod string) string {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+
+	if name, exists := rpcServiceNames[service]; exists {
+		service = name
+	}
+
+	return service + "." + method
+}
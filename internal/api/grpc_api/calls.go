@@ -0,0 +1,82 @@
+package grpc_api
+
+import (
+	"context"
+	"sparallel_server/internal/api/middleware"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIdKey = "x-request-id"
+
+type calls struct {
+	middleware middleware.Middleware
+}
+
+func (c *calls) unary(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	call := c.newCall(ctx, info.FullMethod)
+
+	var response any
+	var err error
+
+	c.middleware(call, func() {
+		response, err = handler(ctx, request)
+
+		if err != nil {
+			call.Error = err.Error()
+		}
+	})
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIdKey, call.RequestId))
+
+	return response, recoveredError(call, err)
+}
+
+func (c *calls) stream(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	call := c.newCall(stream.Context(), info.FullMethod)
+
+	var err error
+
+	c.middleware(call, func() {
+		_ = stream.SetHeader(metadata.Pairs(requestIdKey, call.RequestId))
+
+		err = handler(server, stream)
+
+		if err != nil {
+			call.Error = err.Error()
+		}
+	})
+
+	return recoveredError(call, err)
+}
+
+func (c *calls) newCall(ctx context.Context, fullMethod string) *middleware.Call {
+	requestId := ""
+
+	if values := metadata.ValueFromIncomingContext(ctx, requestIdKey); len(values) > 0 {
+		requestId = values[0]
+	}
+
+	return middleware.NewCall(toServiceMethod(fullMethod), requestId)
+}
+
+func recoveredError(call *middleware.Call, err error) error {
+	if err == nil && call.Error != "" {
+		return status.Error(codes.Internal, call.Error)
+	}
+
+	return err
+}
@@ -0,0 +1,123 @@
+package grpc_api
+
+import (
+	"context"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/api/rpc/rpc_manager"
+)
+
+type managerService struct {
+	pb.UnimplementedManagerServiceServer
+
+	server *rpc_manager.ManagerServer
+}
+
+func (m *managerService) Sleep(_ context.Context, request *pb.MessageRequest) (*pb.AnswerResponse, error) {
+	var reply rpc_manager.SleepResult
+
+	err := m.server.Sleep(&rpc_manager.SleepArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AnswerResponse{Answer: reply.Answer}, nil
+}
+
+func (m *managerService) WakeUp(_ context.Context, request *pb.MessageRequest) (*pb.AnswerResponse, error) {
+	var reply rpc_manager.WakeUpResult
+
+	err := m.server.WakeUp(&rpc_manager.WakeUpArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AnswerResponse{Answer: reply.Answer}, nil
+}
+
+func (m *managerService) Stop(_ context.Context, request *pb.MessageRequest) (*pb.AnswerResponse, error) {
+	var reply rpc_manager.StopResult
+
+	err := m.server.Stop(&rpc_manager.StopArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AnswerResponse{Answer: reply.Answer}, nil
+}
+
+func (m *managerService) Stats(_ context.Context, request *pb.MessageRequest) (*pb.JsonResponse, error) {
+	var reply rpc_manager.StatsResult
+
+	err := m.server.Stats(&rpc_manager.StatsArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.JsonResponse{Json: reply.Json}, nil
+}
+
+func (m *managerService) SetRateLimit(_ context.Context, request *pb.SetRateLimitRequest) (*pb.AnswerResponse, error) {
+	var reply rpc_manager.SetRateLimitResult
+
+	err := m.server.SetRateLimit(
+		&rpc_manager.SetRateLimitArgs{
+			Scope: request.GetScope(),
+			Key:   request.GetKey(),
+			Rate:  request.GetRate(),
+			Burst: int(request.GetBurst()),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AnswerResponse{Answer: reply.Answer}, nil
+}
+
+func (m *managerService) SetChaosFault(_ context.Context, request *pb.SetChaosFaultRequest) (*pb.AnswerResponse, error) {
+	var reply rpc_manager.SetChaosFaultResult
+
+	err := m.server.SetChaosFault(
+		&rpc_manager.SetChaosFaultArgs{
+			Fault: request.GetFault(),
+			Rate:  request.GetRate(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AnswerResponse{Answer: reply.Answer}, nil
+}
+
+func (m *managerService) ChaosStats(_ context.Context, request *pb.MessageRequest) (*pb.JsonResponse, error) {
+	var reply rpc_manager.ChaosStatsResult
+
+	err := m.server.ChaosStats(&rpc_manager.ChaosStatsArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.JsonResponse{Json: reply.Json}, nil
+}
+
+func (m *managerService) Capabilities(_ context.Context, request *pb.MessageRequest) (*pb.JsonResponse, error) {
+	var reply rpc_manager.CapabilitiesResult
+
+	err := m.server.Capabilities(&rpc_manager.CapabilitiesArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.JsonResponse{Json: reply.Json}, nil
+}
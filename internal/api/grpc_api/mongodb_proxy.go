@@ -0,0 +1,184 @@
+package grpc_api
+
+import (
+	"context"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/api/rpc/rpc_proxy_mongodb"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const resultPollInterval = 10 * time.Millisecond
+
+type mongodbProxyService struct {
+	pb.UnimplementedMongodbProxyServiceServer
+
+	server *rpc_proxy_mongodb.ProxyMongodbServer
+}
+
+type resultMethod func(args *rpc_proxy_mongodb.ResultArgs, reply *rpc_proxy_mongodb.ResultReply) error
+
+func (m *mongodbProxyService) InsertOne(_ context.Context, request *pb.InsertOneRequest) (*pb.OperationResponse, error) {
+	var reply rpc_proxy_mongodb.InsertOneReply
+
+	err := m.server.InsertOne(
+		&rpc_proxy_mongodb.InsertOneArgs{
+			Connection: request.GetConnection(),
+			Database:   request.GetDatabase(),
+			Collection: request.GetCollection(),
+			Document:   request.GetDocument(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.OperationResponse{Error: reply.Error, OperationUuid: reply.OperationUuid}, nil
+}
+
+func (m *mongodbProxyService) InsertOneResult(_ context.Context, request *pb.ResultRequest) (*pb.ResultResponse, error) {
+	return m.result(m.server.InsertOneResult, request.GetOperationUuid())
+}
+
+func (m *mongodbProxyService) UpdateOne(_ context.Context, request *pb.UpdateOneRequest) (*pb.OperationResponse, error) {
+	var reply rpc_proxy_mongodb.UpdateOneReply
+
+	err := m.server.UpdateOne(
+		&rpc_proxy_mongodb.UpdateOneArgs{
+			Connection: request.GetConnection(),
+			Database:   request.GetDatabase(),
+			Collection: request.GetCollection(),
+			Filter:     request.GetFilter(),
+			Update:     request.GetUpdate(),
+			OpUpsert:   request.GetOpUpsert(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.OperationResponse{Error: reply.Error, OperationUuid: reply.OperationUuid}, nil
+}
+
+func (m *mongodbProxyService) UpdateOneResult(_ context.Context, request *pb.ResultRequest) (*pb.ResultResponse, error) {
+	return m.result(m.server.UpdateOneResult, request.GetOperationUuid())
+}
+
+func (m *mongodbProxyService) BulkWrite(_ context.Context, request *pb.BulkWriteRequest) (*pb.OperationResponse, error) {
+	var reply rpc_proxy_mongodb.BulkWriteReply
+
+	err := m.server.BulkWrite(
+		&rpc_proxy_mongodb.BulkWriteArgs{
+			Connection: request.GetConnection(),
+			Database:   request.GetDatabase(),
+			Collection: request.GetCollection(),
+			Models:     request.GetModels(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.OperationResponse{Error: reply.Error, OperationUuid: reply.OperationUuid}, nil
+}
+
+func (m *mongodbProxyService) BulkWriteResult(_ context.Context, request *pb.ResultRequest) (*pb.ResultResponse, error) {
+	return m.result(m.server.BulkWriteResult, request.GetOperationUuid())
+}
+
+func (m *mongodbProxyService) Aggregate(_ context.Context, request *pb.AggregateRequest) (*pb.OperationResponse, error) {
+	var reply rpc_proxy_mongodb.AggregateReply
+
+	err := m.server.Aggregate(
+		&rpc_proxy_mongodb.AggregateArgs{
+			Connection: request.GetConnection(),
+			Database:   request.GetDatabase(),
+			Collection: request.GetCollection(),
+			Pipeline:   request.GetPipeline(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.OperationResponse{Error: reply.Error, OperationUuid: reply.OperationUuid}, nil
+}
+
+func (m *mongodbProxyService) AggregateResult(_ context.Context, request *pb.ResultRequest) (*pb.ResultResponse, error) {
+	return m.result(m.server.AggregateResult, request.GetOperationUuid())
+}
+
+func (m *mongodbProxyService) StreamAggregate(
+	request *pb.AggregateRequest,
+	stream grpc.ServerStreamingServer[pb.ResultResponse],
+) error {
+	operation, err := m.Aggregate(stream.Context(), request)
+
+	if err != nil {
+		return err
+	}
+
+	if operation.GetError() != "" {
+		return stream.Send(&pb.ResultResponse{IsFinished: true, Error: operation.GetError()})
+	}
+
+	ticker := time.NewTicker(resultPollInterval)
+	defer ticker.Stop()
+
+	operationUuid := operation.GetOperationUuid()
+
+	for operationUuid != "" {
+		result, err := m.result(m.server.AggregateResult, operationUuid)
+
+		if err != nil {
+			return err
+		}
+
+		if !result.GetIsFinished() {
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-ticker.C:
+				continue
+			}
+		}
+
+		if err = stream.Send(result); err != nil {
+			return err
+		}
+
+		if result.GetError() != "" {
+			return nil
+		}
+
+		operationUuid = result.GetNextUuid()
+	}
+
+	return nil
+}
+
+func (m *mongodbProxyService) result(method resultMethod, operationUuid string) (*pb.ResultResponse, error) {
+	var reply rpc_proxy_mongodb.ResultReply
+
+	err := method(&rpc_proxy_mongodb.ResultArgs{OperationUuid: operationUuid}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ResultResponse{
+		IsFinished: reply.IsFinished,
+		Error:      reply.Error,
+		Result:     reply.Result,
+		NextUuid:   reply.NextUuid,
+	}, nil
+}
@@ -0,0 +1,372 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: sparallel/v1/manager.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRequest) Reset() {
+	*x = MessageRequest{}
+	mi := &file_sparallel_v1_manager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRequest) ProtoMessage() {}
+
+func (x *MessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_manager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRequest.ProtoReflect.Descriptor instead.
+func (*MessageRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_manager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        string                 `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerResponse) Reset() {
+	*x = AnswerResponse{}
+	mi := &file_sparallel_v1_manager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerResponse) ProtoMessage() {}
+
+func (x *AnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_manager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerResponse.ProtoReflect.Descriptor instead.
+func (*AnswerResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnswerResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type JsonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonResponse) Reset() {
+	*x = JsonResponse{}
+	mi := &file_sparallel_v1_manager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonResponse) ProtoMessage() {}
+
+func (x *JsonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_manager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonResponse.ProtoReflect.Descriptor instead.
+func (*JsonResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JsonResponse) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type SetRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst         int64                  `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRateLimitRequest) Reset() {
+	*x = SetRateLimitRequest{}
+	mi := &file_sparallel_v1_manager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRateLimitRequest) ProtoMessage() {}
+
+func (x *SetRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_manager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetRateLimitRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *SetRateLimitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetRateLimitRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SetRateLimitRequest) GetBurst() int64 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type SetChaosFaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fault         string                 `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChaosFaultRequest) Reset() {
+	*x = SetChaosFaultRequest{}
+	mi := &file_sparallel_v1_manager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChaosFaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChaosFaultRequest) ProtoMessage() {}
+
+func (x *SetChaosFaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_manager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChaosFaultRequest.ProtoReflect.Descriptor instead.
+func (*SetChaosFaultRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetChaosFaultRequest) GetFault() string {
+	if x != nil {
+		return x.Fault
+	}
+	return ""
+}
+
+func (x *SetChaosFaultRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+var File_sparallel_v1_manager_proto protoreflect.FileDescriptor
+
+const file_sparallel_v1_manager_proto_rawDesc = "" +
+	"\n" +
+	"\x1asparallel/v1/manager.proto\x12\fsparallel.v1\"*\n" +
+	"\x0eMessageRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"(\n" +
+	"\x0eAnswerResponse\x12\x16\n" +
+	"\x06answer\x18\x01 \x01(\tR\x06answer\"\"\n" +
+	"\fJsonResponse\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\"g\n" +
+	"\x13SetRateLimitRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x04 \x01(\x03R\x05burst\"@\n" +
+	"\x14SetChaosFaultRequest\x12\x14\n" +
+	"\x05fault\x18\x01 \x01(\tR\x05fault\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate2\xd8\x04\n" +
+	"\x0eManagerService\x12C\n" +
+	"\x05Sleep\x12\x1c.sparallel.v1.MessageRequest\x1a\x1c.sparallel.v1.AnswerResponse\x12D\n" +
+	"\x06WakeUp\x12\x1c.sparallel.v1.MessageRequest\x1a\x1c.sparallel.v1.AnswerResponse\x12B\n" +
+	"\x04Stop\x12\x1c.sparallel.v1.MessageRequest\x1a\x1c.sparallel.v1.AnswerResponse\x12A\n" +
+	"\x05Stats\x12\x1c.sparallel.v1.MessageRequest\x1a\x1a.sparallel.v1.JsonResponse\x12O\n" +
+	"\fSetRateLimit\x12!.sparallel.v1.SetRateLimitRequest\x1a\x1c.sparallel.v1.AnswerResponse\x12Q\n" +
+	"\rSetChaosFault\x12\".sparallel.v1.SetChaosFaultRequest\x1a\x1c.sparallel.v1.AnswerResponse\x12F\n" +
+	"\n" +
+	"ChaosStats\x12\x1c.sparallel.v1.MessageRequest\x1a\x1a.sparallel.v1.JsonResponse\x12H\n" +
+	"\fCapabilities\x12\x1c.sparallel.v1.MessageRequest\x1a\x1a.sparallel.v1.JsonResponseB.Z,sparallel_server/internal/api/grpc_api/pb;pbb\x06proto3"
+
+var (
+	file_sparallel_v1_manager_proto_rawDescOnce sync.Once
+	file_sparallel_v1_manager_proto_rawDescData []byte
+)
+
+func file_sparallel_v1_manager_proto_rawDescGZIP() []byte {
+	file_sparallel_v1_manager_proto_rawDescOnce.Do(func() {
+		file_sparallel_v1_manager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sparallel_v1_manager_proto_rawDesc), len(file_sparallel_v1_manager_proto_rawDesc)))
+	})
+	return file_sparallel_v1_manager_proto_rawDescData
+}
+
+var file_sparallel_v1_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sparallel_v1_manager_proto_goTypes = []any{
+	(*MessageRequest)(nil),       // 0: sparallel.v1.MessageRequest
+	(*AnswerResponse)(nil),       // 1: sparallel.v1.AnswerResponse
+	(*JsonResponse)(nil),         // 2: sparallel.v1.JsonResponse
+	(*SetRateLimitRequest)(nil),  // 3: sparallel.v1.SetRateLimitRequest
+	(*SetChaosFaultRequest)(nil), // 4: sparallel.v1.SetChaosFaultRequest
+}
+var file_sparallel_v1_manager_proto_depIdxs = []int32{
+	0, // 0: sparallel.v1.ManagerService.Sleep:input_type -> sparallel.v1.MessageRequest
+	0, // 1: sparallel.v1.ManagerService.WakeUp:input_type -> sparallel.v1.MessageRequest
+	0, // 2: sparallel.v1.ManagerService.Stop:input_type -> sparallel.v1.MessageRequest
+	0, // 3: sparallel.v1.ManagerService.Stats:input_type -> sparallel.v1.MessageRequest
+	3, // 4: sparallel.v1.ManagerService.SetRateLimit:input_type -> sparallel.v1.SetRateLimitRequest
+	4, // 5: sparallel.v1.ManagerService.SetChaosFault:input_type -> sparallel.v1.SetChaosFaultRequest
+	0, // 6: sparallel.v1.ManagerService.ChaosStats:input_type -> sparallel.v1.MessageRequest
+	0, // 7: sparallel.v1.ManagerService.Capabilities:input_type -> sparallel.v1.MessageRequest
+	1, // 8: sparallel.v1.ManagerService.Sleep:output_type -> sparallel.v1.AnswerResponse
+	1, // 9: sparallel.v1.ManagerService.WakeUp:output_type -> sparallel.v1.AnswerResponse
+	1, // 10: sparallel.v1.ManagerService.Stop:output_type -> sparallel.v1.AnswerResponse
+	2, // 11: sparallel.v1.ManagerService.Stats:output_type -> sparallel.v1.JsonResponse
+	1, // 12: sparallel.v1.ManagerService.SetRateLimit:output_type -> sparallel.v1.AnswerResponse
+	1, // 13: sparallel.v1.ManagerService.SetChaosFault:output_type -> sparallel.v1.AnswerResponse
+	2, // 14: sparallel.v1.ManagerService.ChaosStats:output_type -> sparallel.v1.JsonResponse
+	2, // 15: sparallel.v1.ManagerService.Capabilities:output_type -> sparallel.v1.JsonResponse
+	8, // [8:16] is the sub-list for method output_type
+	0, // [0:8] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sparallel_v1_manager_proto_init() }
+func file_sparallel_v1_manager_proto_init() {
+	if File_sparallel_v1_manager_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sparallel_v1_manager_proto_rawDesc), len(file_sparallel_v1_manager_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sparallel_v1_manager_proto_goTypes,
+		DependencyIndexes: file_sparallel_v1_manager_proto_depIdxs,
+		MessageInfos:      file_sparallel_v1_manager_proto_msgTypes,
+	}.Build()
+	File_sparallel_v1_manager_proto = out.File
+	file_sparallel_v1_manager_proto_goTypes = nil
+	file_sparallel_v1_manager_proto_depIdxs = nil
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: sparallel/v1/mongodb_proxy.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InsertOneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Document      string                 `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertOneRequest) Reset() {
+	*x = InsertOneRequest{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOneRequest) ProtoMessage() {}
+
+func (x *InsertOneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOneRequest.ProtoReflect.Descriptor instead.
+func (*InsertOneRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InsertOneRequest) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *InsertOneRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *InsertOneRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *InsertOneRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+type UpdateOneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Update        string                 `protobuf:"bytes,5,opt,name=update,proto3" json:"update,omitempty"`
+	OpUpsert      bool                   `protobuf:"varint,6,opt,name=op_upsert,json=opUpsert,proto3" json:"op_upsert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOneRequest) Reset() {
+	*x = UpdateOneRequest{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOneRequest) ProtoMessage() {}
+
+func (x *UpdateOneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOneRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateOneRequest) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *UpdateOneRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *UpdateOneRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *UpdateOneRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *UpdateOneRequest) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
+func (x *UpdateOneRequest) GetOpUpsert() bool {
+	if x != nil {
+		return x.OpUpsert
+	}
+	return false
+}
+
+type BulkWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Models        string                 `protobuf:"bytes,4,opt,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkWriteRequest) Reset() {
+	*x = BulkWriteRequest{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkWriteRequest) ProtoMessage() {}
+
+func (x *BulkWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkWriteRequest.ProtoReflect.Descriptor instead.
+func (*BulkWriteRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BulkWriteRequest) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *BulkWriteRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *BulkWriteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *BulkWriteRequest) GetModels() string {
+	if x != nil {
+		return x.Models
+	}
+	return ""
+}
+
+type AggregateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Pipeline      string                 `protobuf:"bytes,4,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AggregateRequest) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+type OperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	OperationUuid string                 `protobuf:"bytes,2,opt,name=operation_uuid,json=operationUuid,proto3" json:"operation_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationResponse) Reset() {
+	*x = OperationResponse{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationResponse) ProtoMessage() {}
+
+func (x *OperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationResponse.ProtoReflect.Descriptor instead.
+func (*OperationResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OperationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OperationResponse) GetOperationUuid() string {
+	if x != nil {
+		return x.OperationUuid
+	}
+	return ""
+}
+
+type ResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationUuid string                 `protobuf:"bytes,1,opt,name=operation_uuid,json=operationUuid,proto3" json:"operation_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultRequest) Reset() {
+	*x = ResultRequest{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultRequest) ProtoMessage() {}
+
+func (x *ResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultRequest.ProtoReflect.Descriptor instead.
+func (*ResultRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResultRequest) GetOperationUuid() string {
+	if x != nil {
+		return x.OperationUuid
+	}
+	return ""
+}
+
+type ResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFinished    bool                   `protobuf:"varint,1,opt,name=is_finished,json=isFinished,proto3" json:"is_finished,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	NextUuid      string                 `protobuf:"bytes,4,opt,name=next_uuid,json=nextUuid,proto3" json:"next_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultResponse) Reset() {
+	*x = ResultResponse{}
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultResponse) ProtoMessage() {}
+
+func (x *ResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_mongodb_proxy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultResponse.ProtoReflect.Descriptor instead.
+func (*ResultResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResultResponse) GetIsFinished() bool {
+	if x != nil {
+		return x.IsFinished
+	}
+	return false
+}
+
+func (x *ResultResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ResultResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ResultResponse) GetNextUuid() string {
+	if x != nil {
+		return x.NextUuid
+	}
+	return ""
+}
+
+var File_sparallel_v1_mongodb_proxy_proto protoreflect.FileDescriptor
+
+const file_sparallel_v1_mongodb_proxy_proto_rawDesc = "" +
+	"\n" +
+	" sparallel/v1/mongodb_proxy.proto\x12\fsparallel.v1\"\x8a\x01\n" +
+	"\x10InsertOneRequest\x12\x1e\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\tR\n" +
+	"connection\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x1a\n" +
+	"\bdocument\x18\x04 \x01(\tR\bdocument\"\xbb\x01\n" +
+	"\x10UpdateOneRequest\x12\x1e\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\tR\n" +
+	"connection\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x16\n" +
+	"\x06update\x18\x05 \x01(\tR\x06update\x12\x1b\n" +
+	"\top_upsert\x18\x06 \x01(\bR\bopUpsert\"\x86\x01\n" +
+	"\x10BulkWriteRequest\x12\x1e\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\tR\n" +
+	"connection\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x16\n" +
+	"\x06models\x18\x04 \x01(\tR\x06models\"\x8a\x01\n" +
+	"\x10AggregateRequest\x12\x1e\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\tR\n" +
+	"connection\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x1a\n" +
+	"\bpipeline\x18\x04 \x01(\tR\bpipeline\"P\n" +
+	"\x11OperationResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12%\n" +
+	"\x0eoperation_uuid\x18\x02 \x01(\tR\roperationUuid\"6\n" +
+	"\rResultRequest\x12%\n" +
+	"\x0eoperation_uuid\x18\x01 \x01(\tR\roperationUuid\"|\n" +
+	"\x0eResultResponse\x12\x1f\n" +
+	"\vis_finished\x18\x01 \x01(\bR\n" +
+	"isFinished\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x1b\n" +
+	"\tnext_uuid\x18\x04 \x01(\tR\bnextUuid2\xd8\x05\n" +
+	"\x13MongodbProxyService\x12L\n" +
+	"\tInsertOne\x12\x1e.sparallel.v1.InsertOneRequest\x1a\x1f.sparallel.v1.OperationResponse\x12L\n" +
+	"\x0fInsertOneResult\x12\x1b.sparallel.v1.ResultRequest\x1a\x1c.sparallel.v1.ResultResponse\x12L\n" +
+	"\tUpdateOne\x12\x1e.sparallel.v1.UpdateOneRequest\x1a\x1f.sparallel.v1.OperationResponse\x12L\n" +
+	"\x0fUpdateOneResult\x12\x1b.sparallel.v1.ResultRequest\x1a\x1c.sparallel.v1.ResultResponse\x12L\n" +
+	"\tBulkWrite\x12\x1e.sparallel.v1.BulkWriteRequest\x1a\x1f.sparallel.v1.OperationResponse\x12L\n" +
+	"\x0fBulkWriteResult\x12\x1b.sparallel.v1.ResultRequest\x1a\x1c.sparallel.v1.ResultResponse\x12L\n" +
+	"\tAggregate\x12\x1e.sparallel.v1.AggregateRequest\x1a\x1f.sparallel.v1.OperationResponse\x12L\n" +
+	"\x0fAggregateResult\x12\x1b.sparallel.v1.ResultRequest\x1a\x1c.sparallel.v1.ResultResponse\x12Q\n" +
+	"\x0fStreamAggregate\x12\x1e.sparallel.v1.AggregateRequest\x1a\x1c.sparallel.v1.ResultResponse0\x01B.Z,sparallel_server/internal/api/grpc_api/pb;pbb\x06proto3"
+
+var (
+	file_sparallel_v1_mongodb_proxy_proto_rawDescOnce sync.Once
+	file_sparallel_v1_mongodb_proxy_proto_rawDescData []byte
+)
+
+func file_sparallel_v1_mongodb_proxy_proto_rawDescGZIP() []byte {
+	file_sparallel_v1_mongodb_proxy_proto_rawDescOnce.Do(func() {
+		file_sparallel_v1_mongodb_proxy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sparallel_v1_mongodb_proxy_proto_rawDesc), len(file_sparallel_v1_mongodb_proxy_proto_rawDesc)))
+	})
+	return file_sparallel_v1_mongodb_proxy_proto_rawDescData
+}
+
+var file_sparallel_v1_mongodb_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_sparallel_v1_mongodb_proxy_proto_goTypes = []any{
+	(*InsertOneRequest)(nil),  // 0: sparallel.v1.InsertOneRequest
+	(*UpdateOneRequest)(nil),  // 1: sparallel.v1.UpdateOneRequest
+	(*BulkWriteRequest)(nil),  // 2: sparallel.v1.BulkWriteRequest
+	(*AggregateRequest)(nil),  // 3: sparallel.v1.AggregateRequest
+	(*OperationResponse)(nil), // 4: sparallel.v1.OperationResponse
+	(*ResultRequest)(nil),     // 5: sparallel.v1.ResultRequest
+	(*ResultResponse)(nil),    // 6: sparallel.v1.ResultResponse
+}
+var file_sparallel_v1_mongodb_proxy_proto_depIdxs = []int32{
+	0, // 0: sparallel.v1.MongodbProxyService.InsertOne:input_type -> sparallel.v1.InsertOneRequest
+	5, // 1: sparallel.v1.MongodbProxyService.InsertOneResult:input_type -> sparallel.v1.ResultRequest
+	1, // 2: sparallel.v1.MongodbProxyService.UpdateOne:input_type -> sparallel.v1.UpdateOneRequest
+	5, // 3: sparallel.v1.MongodbProxyService.UpdateOneResult:input_type -> sparallel.v1.ResultRequest
+	2, // 4: sparallel.v1.MongodbProxyService.BulkWrite:input_type -> sparallel.v1.BulkWriteRequest
+	5, // 5: sparallel.v1.MongodbProxyService.BulkWriteResult:input_type -> sparallel.v1.ResultRequest
+	3, // 6: sparallel.v1.MongodbProxyService.Aggregate:input_type -> sparallel.v1.AggregateRequest
+	5, // 7: sparallel.v1.MongodbProxyService.AggregateResult:input_type -> sparallel.v1.ResultRequest
+	3, // 8: sparallel.v1.MongodbProxyService.StreamAggregate:input_type -> sparallel.v1.AggregateRequest
+	4, // 9: sparallel.v1.MongodbProxyService.InsertOne:output_type -> sparallel.v1.OperationResponse
+	6, // 10: sparallel.v1.MongodbProxyService.InsertOneResult:output_type -> sparallel.v1.ResultResponse
+	4, // 11: sparallel.v1.MongodbProxyService.UpdateOne:output_type -> sparallel.v1.OperationResponse
+	6, // 12: sparallel.v1.MongodbProxyService.UpdateOneResult:output_type -> sparallel.v1.ResultResponse
+	4, // 13: sparallel.v1.MongodbProxyService.BulkWrite:output_type -> sparallel.v1.OperationResponse
+	6, // 14: sparallel.v1.MongodbProxyService.BulkWriteResult:output_type -> sparallel.v1.ResultResponse
+	4, // 15: sparallel.v1.MongodbProxyService.Aggregate:output_type -> sparallel.v1.OperationResponse
+	6, // 16: sparallel.v1.MongodbProxyService.AggregateResult:output_type -> sparallel.v1.ResultResponse
+	6, // 17: sparallel.v1.MongodbProxyService.StreamAggregate:output_type -> sparallel.v1.ResultResponse
+	9, // [9:18] is the sub-list for method output_type
+	0, // [0:9] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sparallel_v1_mongodb_proxy_proto_init() }
+func file_sparallel_v1_mongodb_proxy_proto_init() {
+	if File_sparallel_v1_mongodb_proxy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sparallel_v1_mongodb_proxy_proto_rawDesc), len(file_sparallel_v1_mongodb_proxy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sparallel_v1_mongodb_proxy_proto_goTypes,
+		DependencyIndexes: file_sparallel_v1_mongodb_proxy_proto_depIdxs,
+		MessageInfos:      file_sparallel_v1_mongodb_proxy_proto_msgTypes,
+	}.Build()
+	File_sparallel_v1_mongodb_proxy_proto = out.File
+	file_sparallel_v1_mongodb_proxy_proto_goTypes = nil
+	file_sparallel_v1_mongodb_proxy_proto_depIdxs = nil
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sparallel/v1/mongodb_proxy.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MongodbProxyService_InsertOne_FullMethodName       = "/sparallel.v1.MongodbProxyService/InsertOne"
+	MongodbProxyService_InsertOneResult_FullMethodName = "/sparallel.v1.MongodbProxyService/InsertOneResult"
+	MongodbProxyService_UpdateOne_FullMethodName       = "/sparallel.v1.MongodbProxyService/UpdateOne"
+	MongodbProxyService_UpdateOneResult_FullMethodName = "/sparallel.v1.MongodbProxyService/UpdateOneResult"
+	MongodbProxyService_BulkWrite_FullMethodName       = "/sparallel.v1.MongodbProxyService/BulkWrite"
+	MongodbProxyService_BulkWriteResult_FullMethodName = "/sparallel.v1.MongodbProxyService/BulkWriteResult"
+	MongodbProxyService_Aggregate_FullMethodName       = "/sparallel.v1.MongodbProxyService/Aggregate"
+	MongodbProxyService_AggregateResult_FullMethodName = "/sparallel.v1.MongodbProxyService/AggregateResult"
+	MongodbProxyService_StreamAggregate_FullMethodName = "/sparallel.v1.MongodbProxyService/StreamAggregate"
+)
+
+// MongodbProxyServiceClient is the client API for MongodbProxyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MongodbProxyService mirrors the goridge ProxyMongodbServer.
+// Operations run in background, their results are polled by the operation uuid.
+type MongodbProxyServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertOneRequest, opts ...grpc.CallOption) (*OperationResponse, error)
+	InsertOneResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	UpdateOne(ctx context.Context, in *UpdateOneRequest, opts ...grpc.CallOption) (*OperationResponse, error)
+	UpdateOneResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	BulkWrite(ctx context.Context, in *BulkWriteRequest, opts ...grpc.CallOption) (*OperationResponse, error)
+	BulkWriteResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*OperationResponse, error)
+	AggregateResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	// StreamAggregate runs the pipeline and streams the batches of the cursor until it is exhausted
+	StreamAggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultResponse], error)
+}
+
+type mongodbProxyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMongodbProxyServiceClient(cc grpc.ClientConnInterface) MongodbProxyServiceClient {
+	return &mongodbProxyServiceClient{cc}
+}
+
+func (c *mongodbProxyServiceClient) InsertOne(ctx context.Context, in *InsertOneRequest, opts ...grpc.CallOption) (*OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) InsertOneResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_InsertOneResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) UpdateOne(ctx context.Context, in *UpdateOneRequest, opts ...grpc.CallOption) (*OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_UpdateOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) UpdateOneResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_UpdateOneResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) BulkWrite(ctx context.Context, in *BulkWriteRequest, opts ...grpc.CallOption) (*OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_BulkWrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) BulkWriteResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_BulkWriteResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_Aggregate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) AggregateResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, MongodbProxyService_AggregateResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mongodbProxyServiceClient) StreamAggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MongodbProxyService_ServiceDesc.Streams[0], MongodbProxyService_StreamAggregate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AggregateRequest, ResultResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MongodbProxyService_StreamAggregateClient = grpc.ServerStreamingClient[ResultResponse]
+
+// MongodbProxyServiceServer is the server API for MongodbProxyService service.
+// All implementations must embed UnimplementedMongodbProxyServiceServer
+// for forward compatibility.
+//
+// MongodbProxyService mirrors the goridge ProxyMongodbServer.
+// Operations run in background, their results are polled by the operation uuid.
+type MongodbProxyServiceServer interface {
+	InsertOne(context.Context, *InsertOneRequest) (*OperationResponse, error)
+	InsertOneResult(context.Context, *ResultRequest) (*ResultResponse, error)
+	UpdateOne(context.Context, *UpdateOneRequest) (*OperationResponse, error)
+	UpdateOneResult(context.Context, *ResultRequest) (*ResultResponse, error)
+	BulkWrite(context.Context, *BulkWriteRequest) (*OperationResponse, error)
+	BulkWriteResult(context.Context, *ResultRequest) (*ResultResponse, error)
+	Aggregate(context.Context, *AggregateRequest) (*OperationResponse, error)
+	AggregateResult(context.Context, *ResultRequest) (*ResultResponse, error)
+	// StreamAggregate runs the pipeline and streams the batches of the cursor until it is exhausted
+	StreamAggregate(*AggregateRequest, grpc.ServerStreamingServer[ResultResponse]) error
+	mustEmbedUnimplementedMongodbProxyServiceServer()
+}
+
+// UnimplementedMongodbProxyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMongodbProxyServiceServer struct{}
+
+func (UnimplementedMongodbProxyServiceServer) InsertOne(context.Context, *InsertOneRequest) (*OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) InsertOneResult(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOneResult not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) UpdateOne(context.Context, *UpdateOneRequest) (*OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOne not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) UpdateOneResult(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOneResult not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) BulkWrite(context.Context, *BulkWriteRequest) (*OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkWrite not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) BulkWriteResult(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkWriteResult not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) Aggregate(context.Context, *AggregateRequest) (*OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) AggregateResult(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateResult not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) StreamAggregate(*AggregateRequest, grpc.ServerStreamingServer[ResultResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAggregate not implemented")
+}
+func (UnimplementedMongodbProxyServiceServer) mustEmbedUnimplementedMongodbProxyServiceServer() {}
+func (UnimplementedMongodbProxyServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeMongodbProxyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MongodbProxyServiceServer will
+// result in compilation errors.
+type UnsafeMongodbProxyServiceServer interface {
+	mustEmbedUnimplementedMongodbProxyServiceServer()
+}
+
+func RegisterMongodbProxyServiceServer(s grpc.ServiceRegistrar, srv MongodbProxyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMongodbProxyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MongodbProxyService_ServiceDesc, srv)
+}
+
+func _MongodbProxyService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).InsertOne(ctx, req.(*InsertOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_InsertOneResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).InsertOneResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_InsertOneResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).InsertOneResult(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_UpdateOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).UpdateOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_UpdateOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).UpdateOne(ctx, req.(*UpdateOneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_UpdateOneResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).UpdateOneResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_UpdateOneResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).UpdateOneResult(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_BulkWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).BulkWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_BulkWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).BulkWrite(ctx, req.(*BulkWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_BulkWriteResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).BulkWriteResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_BulkWriteResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).BulkWriteResult(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_Aggregate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_AggregateResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MongodbProxyServiceServer).AggregateResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MongodbProxyService_AggregateResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MongodbProxyServiceServer).AggregateResult(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MongodbProxyService_StreamAggregate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AggregateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MongodbProxyServiceServer).StreamAggregate(m, &grpc.GenericServerStream[AggregateRequest, ResultResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MongodbProxyService_StreamAggregateServer = grpc.ServerStreamingServer[ResultResponse]
+
+// MongodbProxyService_ServiceDesc is the grpc.ServiceDesc for MongodbProxyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MongodbProxyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sparallel.v1.MongodbProxyService",
+	HandlerType: (*MongodbProxyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _MongodbProxyService_InsertOne_Handler,
+		},
+		{
+			MethodName: "InsertOneResult",
+			Handler:    _MongodbProxyService_InsertOneResult_Handler,
+		},
+		{
+			MethodName: "UpdateOne",
+			Handler:    _MongodbProxyService_UpdateOne_Handler,
+		},
+		{
+			MethodName: "UpdateOneResult",
+			Handler:    _MongodbProxyService_UpdateOneResult_Handler,
+		},
+		{
+			MethodName: "BulkWrite",
+			Handler:    _MongodbProxyService_BulkWrite_Handler,
+		},
+		{
+			MethodName: "BulkWriteResult",
+			Handler:    _MongodbProxyService_BulkWriteResult_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _MongodbProxyService_Aggregate_Handler,
+		},
+		{
+			MethodName: "AggregateResult",
+			Handler:    _MongodbProxyService_AggregateResult_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAggregate",
+			Handler:       _MongodbProxyService_StreamAggregate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sparallel/v1/mongodb_proxy.proto",
+}
@@ -0,0 +1,1149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: sparallel/v1/workers.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadRequest) Reset() {
+	*x = ReloadRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadRequest) ProtoMessage() {}
+
+func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadRequest.ProtoReflect.Descriptor instead.
+func (*ReloadRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReloadRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        string                 `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadResponse) Reset() {
+	*x = ReloadResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadResponse) ProtoMessage() {}
+
+func (x *ReloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadResponse.ProtoReflect.Descriptor instead.
+func (*ReloadResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReloadResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type AddTaskRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid          string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	TaskUuid           string                 `protobuf:"bytes,2,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	TenantId           string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UnixTimeout        int64                  `protobuf:"varint,4,opt,name=unix_timeout,json=unixTimeout,proto3" json:"unix_timeout,omitempty"`
+	Payload            string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	DependsOn          []string               `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	InjectDependencies bool                   `protobuf:"varint,7,opt,name=inject_dependencies,json=injectDependencies,proto3" json:"inject_dependencies,omitempty"`
+	RequiredTags       []string               `protobuf:"bytes,8,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	RoutingKey         string                 `protobuf:"bytes,9,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	GroupRateLimit     float64                `protobuf:"fixed64,10,opt,name=group_rate_limit,json=groupRateLimit,proto3" json:"group_rate_limit,omitempty"`
+	GroupRateBurst     int64                  `protobuf:"varint,11,opt,name=group_rate_burst,json=groupRateBurst,proto3" json:"group_rate_burst,omitempty"`
+	WaitingTtl         int64                  `protobuf:"varint,12,opt,name=waiting_ttl,json=waitingTtl,proto3" json:"waiting_ttl,omitempty"`
+	ResultTtl          int64                  `protobuf:"varint,13,opt,name=result_ttl,json=resultTtl,proto3" json:"result_ttl,omitempty"`
+	Callback           string                 `protobuf:"bytes,14,opt,name=callback,proto3" json:"callback,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddTaskRequest) Reset() {
+	*x = AddTaskRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskRequest) ProtoMessage() {}
+
+func (x *AddTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddTaskRequest) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetUnixTimeout() int64 {
+	if x != nil {
+		return x.UnixTimeout
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *AddTaskRequest) GetInjectDependencies() bool {
+	if x != nil {
+		return x.InjectDependencies
+	}
+	return false
+}
+
+func (x *AddTaskRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+func (x *AddTaskRequest) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetGroupRateLimit() float64 {
+	if x != nil {
+		return x.GroupRateLimit
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetGroupRateBurst() int64 {
+	if x != nil {
+		return x.GroupRateBurst
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetWaitingTtl() int64 {
+	if x != nil {
+		return x.WaitingTtl
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetResultTtl() int64 {
+	if x != nil {
+		return x.ResultTtl
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetCallback() string {
+	if x != nil {
+		return x.Callback
+	}
+	return ""
+}
+
+type AddTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskResponse) Reset() {
+	*x = AddTaskResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskResponse) ProtoMessage() {}
+
+func (x *AddTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTaskResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DetectAnyFinishedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid     string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectAnyFinishedTaskRequest) Reset() {
+	*x = DetectAnyFinishedTaskRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectAnyFinishedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectAnyFinishedTaskRequest) ProtoMessage() {}
+
+func (x *DetectAnyFinishedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectAnyFinishedTaskRequest.ProtoReflect.Descriptor instead.
+func (*DetectAnyFinishedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DetectAnyFinishedTaskRequest) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+type FinishedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid     string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	TaskUuid      string                 `protobuf:"bytes,2,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	IsFinished    bool                   `protobuf:"varint,3,opt,name=is_finished,json=isFinished,proto3" json:"is_finished,omitempty"`
+	Response      string                 `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	IsError       bool                   `protobuf:"varint,5,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`
+	IsTimeout     bool                   `protobuf:"varint,6,opt,name=is_timeout,json=isTimeout,proto3" json:"is_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishedTask) Reset() {
+	*x = FinishedTask{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishedTask) ProtoMessage() {}
+
+func (x *FinishedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishedTask.ProtoReflect.Descriptor instead.
+func (*FinishedTask) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FinishedTask) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+func (x *FinishedTask) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *FinishedTask) GetIsFinished() bool {
+	if x != nil {
+		return x.IsFinished
+	}
+	return false
+}
+
+func (x *FinishedTask) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *FinishedTask) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
+}
+
+func (x *FinishedTask) GetIsTimeout() bool {
+	if x != nil {
+		return x.IsTimeout
+	}
+	return false
+}
+
+type CancelGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid     string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupRequest) Reset() {
+	*x = CancelGroupRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupRequest) ProtoMessage() {}
+
+func (x *CancelGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupRequest.ProtoReflect.Descriptor instead.
+func (*CancelGroupRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelGroupRequest) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+type CancelGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid     string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupResponse) Reset() {
+	*x = CancelGroupResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupResponse) ProtoMessage() {}
+
+func (x *CancelGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupResponse.ProtoReflect.Descriptor instead.
+func (*CancelGroupResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelGroupResponse) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+type GetTaskEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskUuid      string                 `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskEventsRequest) Reset() {
+	*x = GetTaskEventsRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskEventsRequest) ProtoMessage() {}
+
+func (x *GetTaskEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskEventsRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTaskEventsRequest) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+type GetTaskEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskUuid      string                 `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	Events        []*TaskEvent           `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskEventsResponse) Reset() {
+	*x = GetTaskEventsResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskEventsResponse) ProtoMessage() {}
+
+func (x *GetTaskEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskEventsResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTaskEventsResponse) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *GetTaskEventsResponse) GetEvents() []*TaskEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	GroupUuid     string                 `protobuf:"bytes,2,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	TaskUuid      string                 `protobuf:"bytes,3,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Pid           int64                  `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCallbackStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskUuid      string                 `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCallbackStatusRequest) Reset() {
+	*x = GetCallbackStatusRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallbackStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallbackStatusRequest) ProtoMessage() {}
+
+func (x *GetCallbackStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallbackStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCallbackStatusRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCallbackStatusRequest) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+type GetCallbackStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskUuid      string                 `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts      int64                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCallbackStatusResponse) Reset() {
+	*x = GetCallbackStatusResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallbackStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallbackStatusResponse) ProtoMessage() {}
+
+func (x *GetCallbackStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallbackStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCallbackStatusResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCallbackStatusResponse) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *GetCallbackStatusResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GetCallbackStatusResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetCallbackStatusResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetCallbackStatusResponse) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetCallbackStatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *GetCallbackStatusResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type WatchFinishedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuids    []string               `protobuf:"bytes,1,rep,name=group_uuids,json=groupUuids,proto3" json:"group_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchFinishedTasksRequest) Reset() {
+	*x = WatchFinishedTasksRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchFinishedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFinishedTasksRequest) ProtoMessage() {}
+
+func (x *WatchFinishedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFinishedTasksRequest.ProtoReflect.Descriptor instead.
+func (*WatchFinishedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchFinishedTasksRequest) GetGroupUuids() []string {
+	if x != nil {
+		return x.GroupUuids
+	}
+	return nil
+}
+
+type AcknowledgeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupUuid     string                 `protobuf:"bytes,1,opt,name=group_uuid,json=groupUuid,proto3" json:"group_uuid,omitempty"`
+	TaskUuid      string                 `protobuf:"bytes,2,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeTaskRequest) Reset() {
+	*x = AcknowledgeTaskRequest{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeTaskRequest) ProtoMessage() {}
+
+func (x *AcknowledgeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcknowledgeTaskRequest) GetGroupUuid() string {
+	if x != nil {
+		return x.GroupUuid
+	}
+	return ""
+}
+
+func (x *AcknowledgeTaskRequest) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+type AcknowledgeTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acknowledged  bool                   `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeTaskResponse) Reset() {
+	*x = AcknowledgeTaskResponse{}
+	mi := &file_sparallel_v1_workers_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeTaskResponse) ProtoMessage() {}
+
+func (x *AcknowledgeTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sparallel_v1_workers_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeTaskResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeTaskResponse) Descriptor() ([]byte, []int) {
+	return file_sparallel_v1_workers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcknowledgeTaskResponse) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+var File_sparallel_v1_workers_proto protoreflect.FileDescriptor
+
+const file_sparallel_v1_workers_proto_rawDesc = "" +
+	"\n" +
+	"\x1asparallel/v1/workers.proto\x12\fsparallel.v1\")\n" +
+	"\rReloadRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"(\n" +
+	"\x0eReloadResponse\x12\x16\n" +
+	"\x06answer\x18\x01 \x01(\tR\x06answer\"\xec\x03\n" +
+	"\x0eAddTaskRequest\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\x12\x1b\n" +
+	"\ttask_uuid\x18\x02 \x01(\tR\btaskUuid\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12!\n" +
+	"\funix_timeout\x18\x04 \x01(\x03R\vunixTimeout\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x06 \x03(\tR\tdependsOn\x12/\n" +
+	"\x13inject_dependencies\x18\a \x01(\bR\x12injectDependencies\x12#\n" +
+	"\rrequired_tags\x18\b \x03(\tR\frequiredTags\x12\x1f\n" +
+	"\vrouting_key\x18\t \x01(\tR\n" +
+	"routingKey\x12(\n" +
+	"\x10group_rate_limit\x18\n" +
+	" \x01(\x01R\x0egroupRateLimit\x12(\n" +
+	"\x10group_rate_burst\x18\v \x01(\x03R\x0egroupRateBurst\x12\x1f\n" +
+	"\vwaiting_ttl\x18\f \x01(\x03R\n" +
+	"waitingTtl\x12\x1d\n" +
+	"\n" +
+	"result_ttl\x18\r \x01(\x03R\tresultTtl\x12\x1a\n" +
+	"\bcallback\x18\x0e \x01(\tR\bcallback\"%\n" +
+	"\x0fAddTaskResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"=\n" +
+	"\x1cDetectAnyFinishedTaskRequest\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\"\xc1\x01\n" +
+	"\fFinishedTask\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\x12\x1b\n" +
+	"\ttask_uuid\x18\x02 \x01(\tR\btaskUuid\x12\x1f\n" +
+	"\vis_finished\x18\x03 \x01(\bR\n" +
+	"isFinished\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\tR\bresponse\x12\x19\n" +
+	"\bis_error\x18\x05 \x01(\bR\aisError\x12\x1d\n" +
+	"\n" +
+	"is_timeout\x18\x06 \x01(\bR\tisTimeout\"3\n" +
+	"\x12CancelGroupRequest\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\"4\n" +
+	"\x13CancelGroupResponse\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\"3\n" +
+	"\x14GetTaskEventsRequest\x12\x1b\n" +
+	"\ttask_uuid\x18\x01 \x01(\tR\btaskUuid\"e\n" +
+	"\x15GetTaskEventsResponse\x12\x1b\n" +
+	"\ttask_uuid\x18\x01 \x01(\tR\btaskUuid\x12/\n" +
+	"\x06events\x18\x02 \x03(\v2\x17.sparallel.v1.TaskEventR\x06events\"\x9b\x01\n" +
+	"\tTaskEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x02 \x01(\tR\tgroupUuid\x12\x1b\n" +
+	"\ttask_uuid\x18\x03 \x01(\tR\btaskUuid\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x10\n" +
+	"\x03pid\x18\x05 \x01(\x03R\x03pid\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"7\n" +
+	"\x18GetCallbackStatusRequest\x12\x1b\n" +
+	"\ttask_uuid\x18\x01 \x01(\tR\btaskUuid\"\xd8\x01\n" +
+	"\x19GetCallbackStatusResponse\x12\x1b\n" +
+	"\ttask_uuid\x18\x01 \x01(\tR\btaskUuid\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x03R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"<\n" +
+	"\x19WatchFinishedTasksRequest\x12\x1f\n" +
+	"\vgroup_uuids\x18\x01 \x03(\tR\n" +
+	"groupUuids\"T\n" +
+	"\x16AcknowledgeTaskRequest\x12\x1d\n" +
+	"\n" +
+	"group_uuid\x18\x01 \x01(\tR\tgroupUuid\x12\x1b\n" +
+	"\ttask_uuid\x18\x02 \x01(\tR\btaskUuid\"=\n" +
+	"\x17AcknowledgeTaskResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\bR\facknowledged2\xcf\x05\n" +
+	"\x0eWorkersService\x12C\n" +
+	"\x06Reload\x12\x1b.sparallel.v1.ReloadRequest\x1a\x1c.sparallel.v1.ReloadResponse\x12F\n" +
+	"\aAddTask\x12\x1c.sparallel.v1.AddTaskRequest\x1a\x1d.sparallel.v1.AddTaskResponse\x12_\n" +
+	"\x15DetectAnyFinishedTask\x12*.sparallel.v1.DetectAnyFinishedTaskRequest\x1a\x1a.sparallel.v1.FinishedTask\x12R\n" +
+	"\vCancelGroup\x12 .sparallel.v1.CancelGroupRequest\x1a!.sparallel.v1.CancelGroupResponse\x12X\n" +
+	"\rGetTaskEvents\x12\".sparallel.v1.GetTaskEventsRequest\x1a#.sparallel.v1.GetTaskEventsResponse\x12d\n" +
+	"\x11GetCallbackStatus\x12&.sparallel.v1.GetCallbackStatusRequest\x1a'.sparallel.v1.GetCallbackStatusResponse\x12[\n" +
+	"\x12WatchFinishedTasks\x12'.sparallel.v1.WatchFinishedTasksRequest\x1a\x1a.sparallel.v1.FinishedTask0\x01\x12^\n" +
+	"\x0fAcknowledgeTask\x12$.sparallel.v1.AcknowledgeTaskRequest\x1a%.sparallel.v1.AcknowledgeTaskResponseB.Z,sparallel_server/internal/api/grpc_api/pb;pbb\x06proto3"
+
+var (
+	file_sparallel_v1_workers_proto_rawDescOnce sync.Once
+	file_sparallel_v1_workers_proto_rawDescData []byte
+)
+
+func file_sparallel_v1_workers_proto_rawDescGZIP() []byte {
+	file_sparallel_v1_workers_proto_rawDescOnce.Do(func() {
+		file_sparallel_v1_workers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sparallel_v1_workers_proto_rawDesc), len(file_sparallel_v1_workers_proto_rawDesc)))
+	})
+	return file_sparallel_v1_workers_proto_rawDescData
+}
+
+var file_sparallel_v1_workers_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_sparallel_v1_workers_proto_goTypes = []any{
+	(*ReloadRequest)(nil),                // 0: sparallel.v1.ReloadRequest
+	(*ReloadResponse)(nil),               // 1: sparallel.v1.ReloadResponse
+	(*AddTaskRequest)(nil),               // 2: sparallel.v1.AddTaskRequest
+	(*AddTaskResponse)(nil),              // 3: sparallel.v1.AddTaskResponse
+	(*DetectAnyFinishedTaskRequest)(nil), // 4: sparallel.v1.DetectAnyFinishedTaskRequest
+	(*FinishedTask)(nil),                 // 5: sparallel.v1.FinishedTask
+	(*CancelGroupRequest)(nil),           // 6: sparallel.v1.CancelGroupRequest
+	(*CancelGroupResponse)(nil),          // 7: sparallel.v1.CancelGroupResponse
+	(*GetTaskEventsRequest)(nil),         // 8: sparallel.v1.GetTaskEventsRequest
+	(*GetTaskEventsResponse)(nil),        // 9: sparallel.v1.GetTaskEventsResponse
+	(*TaskEvent)(nil),                    // 10: sparallel.v1.TaskEvent
+	(*GetCallbackStatusRequest)(nil),     // 11: sparallel.v1.GetCallbackStatusRequest
+	(*GetCallbackStatusResponse)(nil),    // 12: sparallel.v1.GetCallbackStatusResponse
+	(*WatchFinishedTasksRequest)(nil),    // 13: sparallel.v1.WatchFinishedTasksRequest
+	(*AcknowledgeTaskRequest)(nil),       // 14: sparallel.v1.AcknowledgeTaskRequest
+	(*AcknowledgeTaskResponse)(nil),      // 15: sparallel.v1.AcknowledgeTaskResponse
+}
+var file_sparallel_v1_workers_proto_depIdxs = []int32{
+	10, // 0: sparallel.v1.GetTaskEventsResponse.events:type_name -> sparallel.v1.TaskEvent
+	0,  // 1: sparallel.v1.WorkersService.Reload:input_type -> sparallel.v1.ReloadRequest
+	2,  // 2: sparallel.v1.WorkersService.AddTask:input_type -> sparallel.v1.AddTaskRequest
+	4,  // 3: sparallel.v1.WorkersService.DetectAnyFinishedTask:input_type -> sparallel.v1.DetectAnyFinishedTaskRequest
+	6,  // 4: sparallel.v1.WorkersService.CancelGroup:input_type -> sparallel.v1.CancelGroupRequest
+	8,  // 5: sparallel.v1.WorkersService.GetTaskEvents:input_type -> sparallel.v1.GetTaskEventsRequest
+	11, // 6: sparallel.v1.WorkersService.GetCallbackStatus:input_type -> sparallel.v1.GetCallbackStatusRequest
+	13, // 7: sparallel.v1.WorkersService.WatchFinishedTasks:input_type -> sparallel.v1.WatchFinishedTasksRequest
+	14, // 8: sparallel.v1.WorkersService.AcknowledgeTask:input_type -> sparallel.v1.AcknowledgeTaskRequest
+	1,  // 9: sparallel.v1.WorkersService.Reload:output_type -> sparallel.v1.ReloadResponse
+	3,  // 10: sparallel.v1.WorkersService.AddTask:output_type -> sparallel.v1.AddTaskResponse
+	5,  // 11: sparallel.v1.WorkersService.DetectAnyFinishedTask:output_type -> sparallel.v1.FinishedTask
+	7,  // 12: sparallel.v1.WorkersService.CancelGroup:output_type -> sparallel.v1.CancelGroupResponse
+	9,  // 13: sparallel.v1.WorkersService.GetTaskEvents:output_type -> sparallel.v1.GetTaskEventsResponse
+	12, // 14: sparallel.v1.WorkersService.GetCallbackStatus:output_type -> sparallel.v1.GetCallbackStatusResponse
+	5,  // 15: sparallel.v1.WorkersService.WatchFinishedTasks:output_type -> sparallel.v1.FinishedTask
+	15, // 16: sparallel.v1.WorkersService.AcknowledgeTask:output_type -> sparallel.v1.AcknowledgeTaskResponse
+	9,  // [9:17] is the sub-list for method output_type
+	1,  // [1:9] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_sparallel_v1_workers_proto_init() }
+func file_sparallel_v1_workers_proto_init() {
+	if File_sparallel_v1_workers_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sparallel_v1_workers_proto_rawDesc), len(file_sparallel_v1_workers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sparallel_v1_workers_proto_goTypes,
+		DependencyIndexes: file_sparallel_v1_workers_proto_depIdxs,
+		MessageInfos:      file_sparallel_v1_workers_proto_msgTypes,
+	}.Build()
+	File_sparallel_v1_workers_proto = out.File
+	file_sparallel_v1_workers_proto_goTypes = nil
+	file_sparallel_v1_workers_proto_depIdxs = nil
+}
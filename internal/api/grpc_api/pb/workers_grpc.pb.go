@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sparallel/v1/workers.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorkersService_Reload_FullMethodName                = "/sparallel.v1.WorkersService/Reload"
+	WorkersService_AddTask_FullMethodName               = "/sparallel.v1.WorkersService/AddTask"
+	WorkersService_DetectAnyFinishedTask_FullMethodName = "/sparallel.v1.WorkersService/DetectAnyFinishedTask"
+	WorkersService_CancelGroup_FullMethodName           = "/sparallel.v1.WorkersService/CancelGroup"
+	WorkersService_GetTaskEvents_FullMethodName         = "/sparallel.v1.WorkersService/GetTaskEvents"
+	WorkersService_GetCallbackStatus_FullMethodName     = "/sparallel.v1.WorkersService/GetCallbackStatus"
+	WorkersService_WatchFinishedTasks_FullMethodName    = "/sparallel.v1.WorkersService/WatchFinishedTasks"
+	WorkersService_AcknowledgeTask_FullMethodName       = "/sparallel.v1.WorkersService/AcknowledgeTask"
+)
+
+// WorkersServiceClient is the client API for WorkersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WorkersService mirrors the goridge WorkersServer
+type WorkersServiceClient interface {
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
+	AddTask(ctx context.Context, in *AddTaskRequest, opts ...grpc.CallOption) (*AddTaskResponse, error)
+	DetectAnyFinishedTask(ctx context.Context, in *DetectAnyFinishedTaskRequest, opts ...grpc.CallOption) (*FinishedTask, error)
+	CancelGroup(ctx context.Context, in *CancelGroupRequest, opts ...grpc.CallOption) (*CancelGroupResponse, error)
+	GetTaskEvents(ctx context.Context, in *GetTaskEventsRequest, opts ...grpc.CallOption) (*GetTaskEventsResponse, error)
+	GetCallbackStatus(ctx context.Context, in *GetCallbackStatusRequest, opts ...grpc.CallOption) (*GetCallbackStatusResponse, error)
+	// WatchFinishedTasks streams the finished tasks of the groups, including the ones finished before the call.
+	// A streamed task stays stored until it is acknowledged by AcknowledgeTask or its result ttl passes.
+	WatchFinishedTasks(ctx context.Context, in *WatchFinishedTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FinishedTask], error)
+	AcknowledgeTask(ctx context.Context, in *AcknowledgeTaskRequest, opts ...grpc.CallOption) (*AcknowledgeTaskResponse, error)
+}
+
+type workersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkersServiceClient(cc grpc.ClientConnInterface) WorkersServiceClient {
+	return &workersServiceClient{cc}
+}
+
+func (c *workersServiceClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadResponse)
+	err := c.cc.Invoke(ctx, WorkersService_Reload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) AddTask(ctx context.Context, in *AddTaskRequest, opts ...grpc.CallOption) (*AddTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTaskResponse)
+	err := c.cc.Invoke(ctx, WorkersService_AddTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) DetectAnyFinishedTask(ctx context.Context, in *DetectAnyFinishedTaskRequest, opts ...grpc.CallOption) (*FinishedTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishedTask)
+	err := c.cc.Invoke(ctx, WorkersService_DetectAnyFinishedTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) CancelGroup(ctx context.Context, in *CancelGroupRequest, opts ...grpc.CallOption) (*CancelGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGroupResponse)
+	err := c.cc.Invoke(ctx, WorkersService_CancelGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) GetTaskEvents(ctx context.Context, in *GetTaskEventsRequest, opts ...grpc.CallOption) (*GetTaskEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskEventsResponse)
+	err := c.cc.Invoke(ctx, WorkersService_GetTaskEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) GetCallbackStatus(ctx context.Context, in *GetCallbackStatusRequest, opts ...grpc.CallOption) (*GetCallbackStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCallbackStatusResponse)
+	err := c.cc.Invoke(ctx, WorkersService_GetCallbackStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersServiceClient) WatchFinishedTasks(ctx context.Context, in *WatchFinishedTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FinishedTask], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkersService_ServiceDesc.Streams[0], WorkersService_WatchFinishedTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchFinishedTasksRequest, FinishedTask]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkersService_WatchFinishedTasksClient = grpc.ServerStreamingClient[FinishedTask]
+
+func (c *workersServiceClient) AcknowledgeTask(ctx context.Context, in *AcknowledgeTaskRequest, opts ...grpc.CallOption) (*AcknowledgeTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeTaskResponse)
+	err := c.cc.Invoke(ctx, WorkersService_AcknowledgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkersServiceServer is the server API for WorkersService service.
+// All implementations must embed UnimplementedWorkersServiceServer
+// for forward compatibility.
+//
+// WorkersService mirrors the goridge WorkersServer
+type WorkersServiceServer interface {
+	Reload(context.Context, *ReloadRequest) (*ReloadResponse, error)
+	AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error)
+	DetectAnyFinishedTask(context.Context, *DetectAnyFinishedTaskRequest) (*FinishedTask, error)
+	CancelGroup(context.Context, *CancelGroupRequest) (*CancelGroupResponse, error)
+	GetTaskEvents(context.Context, *GetTaskEventsRequest) (*GetTaskEventsResponse, error)
+	GetCallbackStatus(context.Context, *GetCallbackStatusRequest) (*GetCallbackStatusResponse, error)
+	// WatchFinishedTasks streams the finished tasks of the groups, including the ones finished before the call.
+	// A streamed task stays stored until it is acknowledged by AcknowledgeTask or its result ttl passes.
+	WatchFinishedTasks(*WatchFinishedTasksRequest, grpc.ServerStreamingServer[FinishedTask]) error
+	AcknowledgeTask(context.Context, *AcknowledgeTaskRequest) (*AcknowledgeTaskResponse, error)
+	mustEmbedUnimplementedWorkersServiceServer()
+}
+
+// UnimplementedWorkersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkersServiceServer struct{}
+
+func (UnimplementedWorkersServiceServer) Reload(context.Context, *ReloadRequest) (*ReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedWorkersServiceServer) AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTask not implemented")
+}
+func (UnimplementedWorkersServiceServer) DetectAnyFinishedTask(context.Context, *DetectAnyFinishedTaskRequest) (*FinishedTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectAnyFinishedTask not implemented")
+}
+func (UnimplementedWorkersServiceServer) CancelGroup(context.Context, *CancelGroupRequest) (*CancelGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGroup not implemented")
+}
+func (UnimplementedWorkersServiceServer) GetTaskEvents(context.Context, *GetTaskEventsRequest) (*GetTaskEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskEvents not implemented")
+}
+func (UnimplementedWorkersServiceServer) GetCallbackStatus(context.Context, *GetCallbackStatusRequest) (*GetCallbackStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCallbackStatus not implemented")
+}
+func (UnimplementedWorkersServiceServer) WatchFinishedTasks(*WatchFinishedTasksRequest, grpc.ServerStreamingServer[FinishedTask]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFinishedTasks not implemented")
+}
+func (UnimplementedWorkersServiceServer) AcknowledgeTask(context.Context, *AcknowledgeTaskRequest) (*AcknowledgeTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeTask not implemented")
+}
+func (UnimplementedWorkersServiceServer) mustEmbedUnimplementedWorkersServiceServer() {}
+func (UnimplementedWorkersServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWorkersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkersServiceServer will
+// result in compilation errors.
+type UnsafeWorkersServiceServer interface {
+	mustEmbedUnimplementedWorkersServiceServer()
+}
+
+func RegisterWorkersServiceServer(s grpc.ServiceRegistrar, srv WorkersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkersService_ServiceDesc, srv)
+}
+
+func _WorkersService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_Reload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).Reload(ctx, req.(*ReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_AddTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).AddTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_AddTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).AddTask(ctx, req.(*AddTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_DetectAnyFinishedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectAnyFinishedTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).DetectAnyFinishedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_DetectAnyFinishedTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).DetectAnyFinishedTask(ctx, req.(*DetectAnyFinishedTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_CancelGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).CancelGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_CancelGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).CancelGroup(ctx, req.(*CancelGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_GetTaskEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).GetTaskEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_GetTaskEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).GetTaskEvents(ctx, req.(*GetTaskEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_GetCallbackStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCallbackStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).GetCallbackStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_GetCallbackStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).GetCallbackStatus(ctx, req.(*GetCallbackStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkersService_WatchFinishedTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFinishedTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkersServiceServer).WatchFinishedTasks(m, &grpc.GenericServerStream[WatchFinishedTasksRequest, FinishedTask]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkersService_WatchFinishedTasksServer = grpc.ServerStreamingServer[FinishedTask]
+
+func _WorkersService_AcknowledgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServiceServer).AcknowledgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkersService_AcknowledgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServiceServer).AcknowledgeTask(ctx, req.(*AcknowledgeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkersService_ServiceDesc is the grpc.ServiceDesc for WorkersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sparallel.v1.WorkersService",
+	HandlerType: (*WorkersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reload",
+			Handler:    _WorkersService_Reload_Handler,
+		},
+		{
+			MethodName: "AddTask",
+			Handler:    _WorkersService_AddTask_Handler,
+		},
+		{
+			MethodName: "DetectAnyFinishedTask",
+			Handler:    _WorkersService_DetectAnyFinishedTask_Handler,
+		},
+		{
+			MethodName: "CancelGroup",
+			Handler:    _WorkersService_CancelGroup_Handler,
+		},
+		{
+			MethodName: "GetTaskEvents",
+			Handler:    _WorkersService_GetTaskEvents_Handler,
+		},
+		{
+			MethodName: "GetCallbackStatus",
+			Handler:    _WorkersService_GetCallbackStatus_Handler,
+		},
+		{
+			MethodName: "AcknowledgeTask",
+			Handler:    _WorkersService_AcknowledgeTask_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFinishedTasks",
+			Handler:       _WorkersService_WatchFinishedTasks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sparallel/v1/workers.proto",
+}
@@ -0,0 +1,175 @@
+package grpc_api
+
+import (
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/api/listeners"
+	"sparallel_server/internal/api/middleware"
+	"sparallel_server/internal/api/rpc/rpc_manager"
+	"sparallel_server/internal/api/rpc/rpc_proxy_mongodb"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/pkg/foundation/errs"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const shutdownPeriod = 5 * time.Second
+
+// Server serves the services of api/proto over gRPC.
+// The calls go through the same rpc servers as goridge ones, including their pause checks.
+type Server struct {
+	addresses   []listeners.Address
+	permissions os.FileMode
+	grpcServer  *grpc.Server
+	listeners   []net.Listener
+}
+
+func NewServer(
+	addresses []listeners.Address,
+	permissions os.FileMode,
+	tlsConfig *tls.Config,
+	acl *auth.ACL,
+	callsMiddleware middleware.Middleware,
+) *Server {
+	calls := &calls{middleware: callsMiddleware}
+
+	unary := []grpc.UnaryServerInterceptor{calls.unary}
+	stream := []grpc.StreamServerInterceptor{calls.stream}
+
+	if acl != nil {
+		authorizer := &authorizer{acl: acl}
+
+		unary = append(unary, authorizer.unary)
+		stream = append(stream, authorizer.stream)
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+
+	if tlsConfig != nil {
+		// gRPC clients require HTTP/2 negotiated by ALPN, the configs picked per connection lack it
+		options = append(options, grpc.Creds(credentials.NewTLS(listeners.WithNextProtos(tlsConfig, "h2"))))
+	}
+
+	return &Server{
+		addresses:   addresses,
+		permissions: permissions,
+		grpcServer:  grpc.NewServer(options...),
+	}
+}
+
+func (s *Server) Register(server any) {
+	switch server := server.(type) {
+	case *rpc_workers.WorkersServer:
+		pb.RegisterWorkersServiceServer(s.grpcServer, &workersService{server: server})
+	case *rpc_manager.ManagerServer:
+		pb.RegisterManagerServiceServer(s.grpcServer, &managerService{server: server})
+	case *rpc_proxy_mongodb.ProxyMongodbServer:
+		pb.RegisterMongodbProxyServiceServer(s.grpcServer, &mongodbProxyService{server: server})
+	}
+}
+
+// Listen listens without TLS, the handshake is done by the server credentials
+func (s *Server) Listen() error {
+	for _, address := range s.addresses {
+		listener, err := listeners.Listen(address, s.permissions, nil)
+
+		if err != nil {
+			s.closeListeners()
+
+			return err
+		}
+
+		s.listeners = append(s.listeners, listener)
+
+		slog.Info("gRPC server listening on " + address.String())
+	}
+
+	return nil
+}
+
+func (s *Server) Serve() error {
+	served := make(chan error, len(s.listeners))
+
+	for _, listener := range s.listeners {
+		go func() {
+			served <- s.grpcServer.Serve(listener)
+		}()
+	}
+
+	var errList []error
+
+	for range s.listeners {
+		if err := <-served; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			errList = append(errList, err)
+		}
+	}
+
+	return errs.Err(errors.Join(errList...))
+}
+
+func (s *Server) closeListeners() {
+	for _, listener := range s.listeners {
+		_ = listener.Close()
+	}
+
+	s.listeners = nil
+}
+
+// Close stops accepting calls and waits for the running ones, streams are cut after the shutdown period
+func (s *Server) Close() error {
+	slog.Warn("Closing gRPC server...")
+
+	stopped := make(chan struct{})
+
+	go func() {
+		s.grpcServer.GracefulStop()
+
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownPeriod):
+		s.grpcServer.Stop()
+	}
+
+	// stopping misses the listeners which Serve has not taken yet
+	for _, listener := range s.listeners {
+		_ = listener.Close()
+	}
+
+	return nil
+}
+
+// toStatus converts an error of the rpc servers to a gRPC status
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, workers_server.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, workers_server.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, workers_server.ErrClosing),
+		errors.Is(err, workers_server.ErrNotServed),
+		errors.Is(err, rpc_workers.ErrPausing):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
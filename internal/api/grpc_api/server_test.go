@@ -0,0 +1,142 @@
+package grpc_api_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/e2e"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var connection *grpc.ClientConn
+
+func TestMain(m *testing.M) {
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	port, err := e2e.FreePort()
+
+	if err == nil {
+		var harness *e2e.Harness
+
+		harness, err = e2e.Start(map[string]string{
+			"GRPC_PORT": port,
+		})
+
+		if err == nil {
+			connection, err = grpc.NewClient("127.0.0.1:"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+			if err == nil {
+				code := m.Run()
+
+				_ = connection.Close()
+				_ = harness.Close()
+
+				os.Exit(code)
+			}
+		}
+	}
+
+	fmt.Println(err.Error())
+
+	os.Exit(1)
+}
+
+func TestWorkersService_WatchFinishedTasks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := pb.NewWorkersServiceClient(connection)
+
+	stream, err := client.WatchFinishedTasks(ctx, &pb.WatchFinishedTasksRequest{GroupUuids: []string{"grpc-group"}})
+
+	require.NoError(t, err)
+
+	for _, taskUuid := range []string{"grpc-task-1", "grpc-task-2"} {
+		response, err := client.AddTask(ctx, &pb.AddTaskRequest{
+			GroupUuid:   "grpc-group",
+			TaskUuid:    taskUuid,
+			UnixTimeout: time.Now().Add(5 * time.Second).Unix(),
+			Payload:     "echo:" + taskUuid,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, taskUuid, response.GetUuid())
+	}
+
+	received := map[string]string{}
+
+	for len(received) < 2 {
+		task, err := stream.Recv()
+
+		require.NoError(t, err)
+
+		received[task.GetTaskUuid()] = task.GetResponse()
+
+		acknowledged, err := client.AcknowledgeTask(ctx, &pb.AcknowledgeTaskRequest{
+			GroupUuid: task.GetGroupUuid(),
+			TaskUuid:  task.GetTaskUuid(),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, acknowledged.GetAcknowledged())
+	}
+
+	assert.Equal(t, "grpc-task-1", received["grpc-task-1"])
+	assert.Equal(t, "grpc-task-2", received["grpc-task-2"])
+}
+
+func TestManagerService_Stats(t *testing.T) {
+	response, err := pb.NewManagerServiceClient(connection).Stats(context.Background(), &pb.MessageRequest{})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.GetJson())
+}
+
+func TestManagerService_Error(t *testing.T) {
+	_, err := pb.NewManagerServiceClient(connection).SetRateLimit(context.Background(), &pb.SetRateLimitRequest{
+		Scope: "unknown",
+	})
+
+	assert.ErrorContains(t, err, "unknown rate limit scope")
+}
+
+func TestServer_StatusCodes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	workers := pb.NewWorkersServiceClient(connection)
+
+	unixTimeout := time.Now().Add(5 * time.Second).Unix()
+
+	_, err := workers.AddTask(ctx, &pb.AddTaskRequest{
+		GroupUuid:   "grpc-status-group",
+		TaskUuid:    "grpc-status-self",
+		UnixTimeout: unixTimeout,
+		DependsOn:   []string{"grpc-status-self"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = workers.AddTask(ctx, &pb.AddTaskRequest{
+		GroupUuid:   "grpc-status-group",
+		TaskUuid:    "grpc-status-dependent",
+		UnixTimeout: unixTimeout,
+		DependsOn:   []string{"grpc-status-unknown"},
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = pb.NewManagerServiceClient(connection).SetRateLimit(ctx, &pb.SetRateLimitRequest{Scope: "unknown", Rate: 1})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,213 @@
+package grpc_api
+
+import (
+	"context"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/services/workers_server/payloads"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type workersService struct {
+	pb.UnimplementedWorkersServiceServer
+
+	server *rpc_workers.WorkersServer
+}
+
+func (w *workersService) Reload(_ context.Context, request *pb.ReloadRequest) (*pb.ReloadResponse, error) {
+	var reply rpc_workers.ReloadResult
+
+	err := w.server.Reload(&rpc_workers.ReloadArgs{Message: request.GetMessage()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ReloadResponse{Answer: reply.Answer}, nil
+}
+
+func (w *workersService) AddTask(_ context.Context, request *pb.AddTaskRequest) (*pb.AddTaskResponse, error) {
+	var reply rpc_workers.AddTaskResult
+
+	err := w.server.AddTask(
+		&rpc_workers.AddTaskArgs{
+			GroupUuid:          request.GetGroupUuid(),
+			TaskUuid:           request.GetTaskUuid(),
+			TenantId:           request.GetTenantId(),
+			UnixTimeout:        int(request.GetUnixTimeout()),
+			Payload:            request.GetPayload(),
+			DependsOn:          request.GetDependsOn(),
+			InjectDependencies: request.GetInjectDependencies(),
+			RequiredTags:       request.GetRequiredTags(),
+			RoutingKey:         request.GetRoutingKey(),
+			GroupRateLimit:     request.GetGroupRateLimit(),
+			GroupRateBurst:     int(request.GetGroupRateBurst()),
+			WaitingTtl:         int(request.GetWaitingTtl()),
+			ResultTtl:          int(request.GetResultTtl()),
+			Callback:           request.GetCallback(),
+		},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AddTaskResponse{Uuid: reply.Uuid}, nil
+}
+
+func (w *workersService) DetectAnyFinishedTask(
+	_ context.Context,
+	request *pb.DetectAnyFinishedTaskRequest,
+) (*pb.FinishedTask, error) {
+	var reply rpc_workers.DetectFinishedTaskResult
+
+	err := w.server.DetectAnyFinishedTask(&rpc_workers.DetectFinishedTaskArgs{GroupUuid: request.GetGroupUuid()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.FinishedTask{
+		GroupUuid:  reply.GroupUuid,
+		TaskUuid:   reply.TaskUuid,
+		IsFinished: reply.IsFinished,
+		Response:   reply.Response,
+		IsError:    reply.IsError,
+		IsTimeout:  reply.IsTimeout,
+	}, nil
+}
+
+func (w *workersService) CancelGroup(_ context.Context, request *pb.CancelGroupRequest) (*pb.CancelGroupResponse, error) {
+	var reply rpc_workers.CancelGroupResult
+
+	err := w.server.CancelGroup(&rpc_workers.CancelGroupArgs{GroupUuid: request.GetGroupUuid()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CancelGroupResponse{GroupUuid: reply.GroupUuid}, nil
+}
+
+func (w *workersService) GetTaskEvents(
+	_ context.Context,
+	request *pb.GetTaskEventsRequest,
+) (*pb.GetTaskEventsResponse, error) {
+	var reply rpc_workers.GetTaskEventsResult
+
+	err := w.server.GetTaskEvents(&rpc_workers.GetTaskEventsArgs{TaskUuid: request.GetTaskUuid()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.GetTaskEventsResponse{
+		TaskUuid: reply.TaskUuid,
+	}
+
+	for _, event := range reply.Events {
+		response.Events = append(response.Events, &pb.TaskEvent{
+			Time:      event.Time,
+			GroupUuid: event.GroupUuid,
+			TaskUuid:  event.TaskUuid,
+			Type:      event.Type,
+			Pid:       int64(event.Pid),
+			Message:   event.Message,
+		})
+	}
+
+	return response, nil
+}
+
+func (w *workersService) GetCallbackStatus(
+	_ context.Context,
+	request *pb.GetCallbackStatusRequest,
+) (*pb.GetCallbackStatusResponse, error) {
+	var reply rpc_workers.GetCallbackStatusResult
+
+	err := w.server.GetCallbackStatus(&rpc_workers.GetCallbackStatusArgs{TaskUuid: request.GetTaskUuid()}, &reply)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.GetCallbackStatusResponse{
+		TaskUuid:  reply.TaskUuid,
+		Exists:    reply.Exists,
+		Target:    reply.Target,
+		State:     reply.State,
+		Attempts:  int64(reply.Attempts),
+		LastError: reply.LastError,
+		UpdatedAt: reply.UpdatedAt,
+	}, nil
+}
+
+func (w *workersService) WatchFinishedTasks(
+	request *pb.WatchFinishedTasksRequest,
+	stream grpc.ServerStreamingServer[pb.FinishedTask],
+) error {
+	if len(request.GetGroupUuids()) == 0 {
+		return status.Error(codes.InvalidArgument, "group uuids are required")
+	}
+
+	subscriber, err := w.server.WatchFinishedTasks(request.GetGroupUuids())
+
+	if err != nil {
+		return toStatus(err)
+	}
+
+	defer w.server.UnwatchFinishedTasks(subscriber)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case task, ok := <-subscriber.Tasks():
+			if !ok {
+				return nil
+			}
+
+			response, err := payloads.Unpack(task.Response, task.ResponseEncoding)
+
+			if err != nil {
+				// the result was already collected or expired
+				continue
+			}
+
+			err = stream.Send(&pb.FinishedTask{
+				GroupUuid:  task.GroupUuid,
+				TaskUuid:   task.TaskUuid,
+				IsFinished: true,
+				Response:   response,
+				IsError:    task.IsError,
+				IsTimeout:  task.TimedOut,
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (w *workersService) AcknowledgeTask(
+	_ context.Context,
+	request *pb.AcknowledgeTaskRequest,
+) (*pb.AcknowledgeTaskResponse, error) {
+	var reply rpc_workers.AcknowledgeTaskResult
+
+	err := w.server.AcknowledgeTask(
+		&rpc_workers.AcknowledgeTaskArgs{GroupUuid: request.GetGroupUuid(), TaskUuid: request.GetTaskUuid()},
+		&reply,
+	)
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AcknowledgeTaskResponse{Acknowledged: reply.Acknowledged}, nil
+}
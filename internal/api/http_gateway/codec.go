@@ -0,0 +1,69 @@
+package http_gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/rpc"
+)
+
+type codec struct {
+	serviceMethod string
+	body          io.Reader
+
+	headerRead bool
+	decodeErr  error
+
+	authorize func(args any) error
+	authErr   error
+
+	reply    any
+	replyErr string
+}
+
+func (c *codec) ReadRequestHeader(request *rpc.Request) error {
+	if c.headerRead {
+		return io.EOF
+	}
+
+	c.headerRead = true
+
+	request.ServiceMethod = c.serviceMethod
+	request.Seq = 0
+
+	return nil
+}
+
+func (c *codec) ReadRequestBody(args any) error {
+	if args == nil {
+		return nil
+	}
+
+	err := json.NewDecoder(c.body).Decode(args)
+
+	// an empty body means zero arguments
+	if err != nil && !errors.Is(err, io.EOF) {
+		c.decodeErr = err
+
+		return err
+	}
+
+	if c.authorize != nil {
+		c.authErr = c.authorize(args)
+
+		return c.authErr
+	}
+
+	return nil
+}
+
+func (c *codec) WriteResponse(response *rpc.Response, reply any) error {
+	c.reply = reply
+	c.replyErr = response.Error
+
+	return nil
+}
+
+func (c *codec) Close() error {
+	return nil
+}
@@ -0,0 +1,212 @@
+package http_gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/rpc"
+	"os"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/listeners"
+	"sparallel_server/internal/api/middleware"
+	"sparallel_server/pkg/foundation/errs"
+	"strings"
+	"time"
+)
+
+const (
+	routePrefix     = "/rpc/"
+	requestIdHeader = "X-Request-Id"
+	maxBodySize     = 64 * 1024 * 1024
+	shutdownPeriod  = 5 * time.Second
+)
+
+// Server exposes the services registered in net/rpc as JSON endpoints:
+// POST /rpc/{Service}.{Method} with the arguments struct as the body responds with the reply struct.
+// The calls go through the same service methods as goridge ones, including their pause checks.
+// With an acl the token is taken from the "Authorization: Bearer {token}" header.
+type Server struct {
+	addresses   []listeners.Address
+	permissions os.FileMode
+	tlsConfig   *tls.Config
+	acl         *auth.ACL
+	middleware  middleware.Middleware
+	httpServer  *http.Server
+	listeners   []net.Listener
+}
+
+type errorReply struct {
+	Error string
+}
+
+func NewServer(
+	addresses []listeners.Address,
+	permissions os.FileMode,
+	tlsConfig *tls.Config,
+	acl *auth.ACL,
+	calls middleware.Middleware,
+) *Server {
+	server := &Server{
+		addresses:   addresses,
+		permissions: permissions,
+		tlsConfig:   tlsConfig,
+		acl:         acl,
+		middleware:  calls,
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(routePrefix, server.handle)
+
+	server.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server
+}
+
+func (s *Server) Listen() error {
+	for _, address := range s.addresses {
+		listener, err := listeners.Listen(address, s.permissions, s.tlsConfig)
+
+		if err != nil {
+			s.closeListeners()
+
+			return err
+		}
+
+		s.listeners = append(s.listeners, listener)
+
+		slog.Info("HTTP gateway listening on " + address.String())
+	}
+
+	return nil
+}
+
+func (s *Server) Serve() error {
+	served := make(chan error, len(s.listeners))
+
+	for _, listener := range s.listeners {
+		go func() {
+			served <- s.httpServer.Serve(listener)
+		}()
+	}
+
+	var errList []error
+
+	for range s.listeners {
+		if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+			errList = append(errList, err)
+		}
+	}
+
+	return errs.Err(errors.Join(errList...))
+}
+
+func (s *Server) closeListeners() {
+	for _, listener := range s.listeners {
+		_ = listener.Close()
+	}
+
+	s.listeners = nil
+}
+
+func (s *Server) Close() error {
+	slog.Warn("Closing http gateway...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownPeriod)
+	defer cancel()
+
+	err := s.httpServer.Shutdown(ctx)
+
+	// Shutdown misses the listeners which Serve has not taken yet
+	for _, listener := range s.listeners {
+		_ = listener.Close()
+	}
+
+	return errs.Err(err)
+}
+
+func (s *Server) handle(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writeJson(writer, http.StatusMethodNotAllowed, &errorReply{Error: "method must be POST"})
+
+		return
+	}
+
+	serviceMethod := strings.TrimPrefix(request.URL.Path, routePrefix)
+
+	if strings.Count(serviceMethod, ".") != 1 {
+		writeJson(writer, http.StatusNotFound, &errorReply{Error: "route must be " + routePrefix + "{Service}.{Method}"})
+
+		return
+	}
+
+	requestCodec := &codec{
+		serviceMethod: serviceMethod,
+		body:          http.MaxBytesReader(writer, request.Body, maxBodySize),
+	}
+
+	if s.acl != nil {
+		token, hasToken := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+
+		credential := s.acl.Authenticate(token)
+
+		if hasToken && credential == nil {
+			writeJson(writer, http.StatusUnauthorized, &errorReply{Error: auth.ErrUnauthenticated.Error()})
+
+			return
+		}
+
+		requestCodec.authorize = func(args any) error {
+			return s.acl.Authorize(credential, serviceMethod, args)
+		}
+	}
+
+	call := middleware.NewCall(serviceMethod, request.Header.Get(requestIdHeader))
+
+	s.middleware(call, func() {
+		_ = rpc.ServeRequest(requestCodec)
+
+		call.Error = requestCodec.replyErr
+	})
+
+	writer.Header().Set(requestIdHeader, call.RequestId)
+
+	switch {
+	case requestCodec.reply == nil:
+		// the method panicked, the error is set by the middleware
+		writeJson(writer, http.StatusInternalServerError, &errorReply{Error: call.Error})
+	case errors.Is(requestCodec.authErr, auth.ErrUnauthenticated):
+		writeJson(writer, http.StatusUnauthorized, &errorReply{Error: requestCodec.authErr.Error()})
+	case requestCodec.authErr != nil:
+		writeJson(writer, http.StatusForbidden, &errorReply{Error: requestCodec.authErr.Error()})
+	case requestCodec.decodeErr != nil:
+		writeJson(writer, http.StatusBadRequest, &errorReply{Error: "invalid arguments: " + requestCodec.decodeErr.Error()})
+	case strings.HasPrefix(requestCodec.replyErr, "rpc: can't find"):
+		writeJson(writer, http.StatusNotFound, &errorReply{Error: requestCodec.replyErr})
+	case requestCodec.replyErr != "":
+		writeJson(writer, http.StatusInternalServerError, &errorReply{Error: requestCodec.replyErr})
+	default:
+		writeJson(writer, http.StatusOK, requestCodec.reply)
+	}
+}
+
+func writeJson(writer http.ResponseWriter, status int, body any) {
+	data, err := json.Marshal(body)
+
+	if err != nil {
+		status = http.StatusInternalServerError
+		data = []byte(`{"Error":"can't marshal reply"}`)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	_, _ = writer.Write(data)
+}
@@ -0,0 +1,85 @@
+package http_gateway_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sparallel_server/internal/api/rpc/rpc_ping_pong"
+	"sparallel_server/internal/e2e"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var httpPort string
+
+func TestMain(m *testing.M) {
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	port, err := e2e.FreePort()
+
+	if err == nil {
+		httpPort = port
+
+		var harness *e2e.Harness
+
+		harness, err = e2e.Start(map[string]string{
+			"SERVE_WORKERS": "false",
+			"HTTP_PORT":     httpPort,
+		})
+
+		if err == nil {
+			code := m.Run()
+
+			_ = harness.Close()
+
+			os.Exit(code)
+		}
+	}
+
+	fmt.Println(err.Error())
+
+	os.Exit(1)
+}
+
+func TestGateway_Call(t *testing.T) {
+	response := post(t, "PingPongServer.Ping", `{"Message":"hello"}`)
+
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var reply rpc_ping_pong.PingResult
+
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&reply))
+
+	assert.Equal(t, "hello", reply.Message)
+}
+
+func TestGateway_Errors(t *testing.T) {
+	cases := map[string]int{
+		"PingPongServer.Unknown": http.StatusNotFound,
+		"Unknown":                http.StatusNotFound,
+		"PingPongServer.Ping":    http.StatusBadRequest,
+	}
+
+	for route, status := range cases {
+		response := post(t, route, `{"Message":`)
+
+		_ = response.Body.Close()
+
+		assert.Equal(t, status, response.StatusCode, route)
+	}
+}
+
+func post(t *testing.T, route string, body string) *http.Response {
+	response, err := http.Post("http://127.0.0.1:"+httpPort+"/rpc/"+route, "application/json", strings.NewReader(body))
+
+	require.NoError(t, err)
+
+	return response
+}
@@ -0,0 +1,217 @@
+package listeners
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"os"
+	"sparallel_server/pkg/foundation/errs"
+	"strings"
+	"time"
+)
+
+const (
+	schemeUnix = "unix://"
+	schemeTcp  = "tcp://"
+)
+
+const staleCheckTimeout = time.Second
+
+type Address struct {
+	Network string
+	Address string
+}
+
+func (a Address) String() string {
+	return a.Network + "://" + a.Address
+}
+
+func Parse(raw string) (Address, error) {
+	switch {
+	case strings.HasPrefix(raw, schemeUnix):
+		path := strings.TrimPrefix(raw, schemeUnix)
+
+		if path == "" {
+			return Address{}, errs.Err(errors.New("empty socket path of [" + raw + "]"))
+		}
+
+		return Address{Network: "unix", Address: path}, nil
+	case strings.HasPrefix(raw, schemeTcp):
+		hostPort := strings.TrimPrefix(raw, schemeTcp)
+
+		if _, _, err := net.SplitHostPort(hostPort); err != nil {
+			return Address{}, errs.Err(errors.New("invalid tcp address [" + raw + "]: " + err.Error()))
+		}
+
+		return Address{Network: "tcp", Address: hostPort}, nil
+	default:
+		return Address{}, errs.Err(errors.New("listen address [" + raw + "] must start with unix:// or tcp://"))
+	}
+}
+
+func Resolve(rawAddresses []string, port string) ([]Address, error) {
+	if len(rawAddresses) == 0 {
+		return []Address{{Network: "tcp", Address: ":" + port}}, nil
+	}
+
+	var addresses []Address
+
+	for _, raw := range rawAddresses {
+		address, err := Parse(raw)
+
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// ResolveSiblings returns the addresses of a side server on [port] bound like the rpc [addresses]:
+// on the hosts of the tcp ones, on the loopback when the rpc listens on unix sockets only
+func ResolveSiblings(addresses []Address, port string) []Address {
+	var siblings []Address
+
+	seen := make(map[string]bool)
+
+	for _, address := range addresses {
+		if address.Network != "tcp" {
+			continue
+		}
+
+		host, _, err := net.SplitHostPort(address.Address)
+
+		if err != nil {
+			continue
+		}
+
+		hostPort := net.JoinHostPort(host, port)
+
+		if seen[hostPort] {
+			continue
+		}
+
+		seen[hostPort] = true
+
+		siblings = append(siblings, Address{Network: "tcp", Address: hostPort})
+	}
+
+	if len(siblings) == 0 {
+		siblings = append(siblings, Address{Network: "tcp", Address: net.JoinHostPort("127.0.0.1", port)})
+	}
+
+	return siblings
+}
+
+func Listen(address Address, permissions os.FileMode, tlsConfig *tls.Config) (net.Listener, error) {
+	if address.Network != "unix" {
+		listener, err := net.Listen(address.Network, address.Address)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		if tlsConfig != nil {
+			listener = tls.NewListener(listener, tlsConfig)
+		}
+
+		return listener, nil
+	}
+
+	if err := removeStaleSocket(address.Address); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", address.Address)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	if err = os.Chmod(address.Address, permissions); err != nil {
+		_ = listener.Close()
+
+		return nil, errs.Err(err)
+	}
+
+	return listener, nil
+}
+
+// Dial connects to the first unix socket of the listen addresses resolved like Resolve does,
+// to the first address when there are no sockets. Local clients skip TLS of the tcp listeners this way,
+// a tcp connection does the TLS handshake by [tlsConfig] when it is set.
+func Dial(rawAddresses []string, port string, tlsConfig *tls.Config) (net.Conn, error) {
+	addresses, err := Resolve(rawAddresses, port)
+
+	if err != nil {
+		return nil, err
+	}
+
+	address := addresses[0]
+
+	for _, candidate := range addresses {
+		if candidate.Network == "unix" {
+			address = candidate
+
+			break
+		}
+	}
+
+	if address.Network == "unix" || tlsConfig == nil {
+		conn, err := net.Dial(address.Network, address.Address)
+
+		return conn, errs.Err(err)
+	}
+
+	if tlsConfig.ServerName == "" {
+		host, _, err := net.SplitHostPort(address.Address)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		if host == "" {
+			host = "localhost"
+		}
+
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.ServerName = host
+	}
+
+	conn, err := tls.Dial(address.Network, address.Address, tlsConfig)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	return conn, nil
+}
+
+// removeStaleSocket removes the socket file left by a crashed server, a socket which accepts connections is kept
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return errs.Err(errors.New("[" + path + "] exists and is not a socket"))
+	}
+
+	conn, err := net.DialTimeout("unix", path, staleCheckTimeout)
+
+	if err == nil {
+		_ = conn.Close()
+
+		return errs.Err(errors.New("socket [" + path + "] is in use by another server"))
+	}
+
+	return errs.Err(os.Remove(path))
+}
@@ -0,0 +1,135 @@
+package listeners
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	address, err := Parse("unix:///run/sparallel.sock")
+
+	require.NoError(t, err)
+	assert.Equal(t, Address{Network: "unix", Address: "/run/sparallel.sock"}, address)
+
+	address, err = Parse("tcp://127.0.0.1:18077")
+
+	require.NoError(t, err)
+	assert.Equal(t, Address{Network: "tcp", Address: "127.0.0.1:18077"}, address)
+
+	for _, raw := range []string{"unix://", "tcp://127.0.0.1", "udp://127.0.0.1:1", "18077"} {
+		_, err = Parse(raw)
+
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	addresses, err := Resolve(nil, "18077")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Address{{Network: "tcp", Address: ":18077"}}, addresses)
+
+	addresses, err = Resolve([]string{"unix:///tmp/a.sock", "tcp://127.0.0.1:1"}, "18077")
+
+	require.NoError(t, err)
+	assert.Len(t, addresses, 2)
+}
+
+func TestResolveSiblings(t *testing.T) {
+	assert.Equal(
+		t,
+		[]Address{{Network: "tcp", Address: ":8080"}},
+		ResolveSiblings([]Address{{Network: "tcp", Address: ":18077"}}, "8080"),
+	)
+
+	assert.Equal(
+		t,
+		[]Address{{Network: "tcp", Address: "127.0.0.1:8080"}, {Network: "tcp", Address: "[::1]:8080"}},
+		ResolveSiblings(
+			[]Address{
+				{Network: "unix", Address: "/tmp/a.sock"},
+				{Network: "tcp", Address: "127.0.0.1:18077"},
+				{Network: "tcp", Address: "127.0.0.1:18078"},
+				{Network: "tcp", Address: "[::1]:18077"},
+			},
+			"8080",
+		),
+	)
+
+	assert.Equal(
+		t,
+		[]Address{{Network: "tcp", Address: "127.0.0.1:8080"}},
+		ResolveSiblings([]Address{{Network: "unix", Address: "/tmp/a.sock"}}, "8080"),
+	)
+}
+
+func TestListen_Unix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc.sock")
+	address := Address{Network: "unix", Address: path}
+
+	listener, err := Listen(address, 0600, nil)
+
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	go func() {
+		conn, err := listener.Accept()
+
+		if err == nil {
+			_ = conn.Close()
+		}
+	}()
+
+	_, err = Listen(address, 0600, nil)
+
+	assert.ErrorContains(t, err, "in use")
+
+	require.NoError(t, listener.Close())
+
+	_, err = os.Stat(path)
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestListen_StaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc.sock")
+
+	stale, err := net.Listen("unix", path)
+
+	require.NoError(t, err)
+
+	// a crashed server leaves the file without anybody accepting on it
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	require.NoError(t, stale.Close())
+
+	listener, err := Listen(Address{Network: "unix", Address: path}, 0660, nil)
+
+	require.NoError(t, err)
+
+	conn, err := Dial([]string{"unix://" + path}, "", nil)
+
+	require.NoError(t, err)
+
+	_ = conn.Close()
+	_ = listener.Close()
+}
+
+func TestListen_NotSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+
+	_, err := Listen(Address{Network: "unix", Address: path}, 0660, nil)
+
+	assert.ErrorContains(t, err, "not a socket")
+}
@@ -0,0 +1,225 @@
+package listeners
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"os"
+	"sparallel_server/pkg/foundation/errs"
+	"sync"
+	"time"
+)
+
+const tlsCheckInterval = time.Second
+
+// TlsFiles serves the certificate and the client CA bundle from files.
+// Changed files are reloaded on the next handshake, a broken change keeps the previous ones.
+type TlsFiles struct {
+	certPath     string
+	keyPath      string
+	clientCaPath string
+
+	mutex         sync.Mutex
+	checkInterval time.Duration
+	checkedAt     time.Time
+	modTimes      []time.Time
+	config        *tls.Config
+}
+
+func NewTlsFiles(certPath string, keyPath string, clientCaPath string) (*TlsFiles, error) {
+	files := &TlsFiles{
+		certPath:      certPath,
+		keyPath:       keyPath,
+		clientCaPath:  clientCaPath,
+		checkInterval: tlsCheckInterval,
+	}
+
+	modTimes, err := files.stat()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = files.load(modTimes); err != nil {
+		return nil, err
+	}
+
+	files.checkedAt = time.Now()
+
+	return files, nil
+}
+
+func (t *TlsFiles) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			return t.current(), nil
+		},
+	}
+}
+
+func WithNextProtos(config *tls.Config, protocols ...string) *tls.Config {
+	config = config.Clone()
+	config.NextProtos = protocols
+
+	getConfigForClient := config.GetConfigForClient
+
+	if getConfigForClient == nil {
+		return config
+	}
+
+	config.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		picked, err := getConfigForClient(hello)
+
+		if err != nil || picked == nil {
+			return picked, err
+		}
+
+		picked = picked.Clone()
+		picked.NextProtos = protocols
+
+		return picked, nil
+	}
+
+	return config
+}
+
+func NewClientTlsConfig(caPath string, certPath string, keyPath string, serverName string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+
+	if caPath != "" {
+		bundle, err := os.ReadFile(caPath)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		roots := x509.NewCertPool()
+
+		if !roots.AppendCertsFromPEM(bundle) {
+			return nil, errs.Err(errors.New("no certificates in CA bundle [" + caPath + "]"))
+		}
+
+		config.RootCAs = roots
+	}
+
+	if certPath == "" && keyPath == "" {
+		return config, nil
+	}
+
+	if certPath == "" || keyPath == "" {
+		return nil, errs.Err(errors.New("both client TLS certificate and key files are required"))
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	config.Certificates = []tls.Certificate{certificate}
+
+	return config, nil
+}
+
+func (t *TlsFiles) current() *tls.Config {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if time.Since(t.checkedAt) < t.checkInterval {
+		return t.config
+	}
+
+	t.checkedAt = time.Now()
+
+	modTimes, err := t.stat()
+
+	if err != nil {
+		slog.Error("TLS files are not reloaded: " + err.Error())
+
+		return t.config
+	}
+
+	if !t.isChanged(modTimes) {
+		return t.config
+	}
+
+	if err = t.load(modTimes); err != nil {
+		slog.Error("TLS files are not reloaded: " + err.Error())
+
+		return t.config
+	}
+
+	slog.Warn("TLS files are reloaded")
+
+	return t.config
+}
+
+func (t *TlsFiles) load(modTimes []time.Time) error {
+	certificate, err := tls.LoadX509KeyPair(t.certPath, t.keyPath)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+	}
+
+	if t.clientCaPath != "" {
+		bundle, err := os.ReadFile(t.clientCaPath)
+
+		if err != nil {
+			return errs.Err(err)
+		}
+
+		clientCas := x509.NewCertPool()
+
+		if !clientCas.AppendCertsFromPEM(bundle) {
+			return errs.Err(errors.New("no certificates in client CA bundle [" + t.clientCaPath + "]"))
+		}
+
+		config.ClientCAs = clientCas
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	t.config = config
+	t.modTimes = modTimes
+
+	return nil
+}
+
+func (t *TlsFiles) stat() ([]time.Time, error) {
+	var modTimes []time.Time
+
+	for _, path := range []string{t.certPath, t.keyPath, t.clientCaPath} {
+		if path == "" {
+			continue
+		}
+
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	return modTimes, nil
+}
+
+func (t *TlsFiles) isChanged(modTimes []time.Time) bool {
+	for i, modTime := range modTimes {
+		if !modTime.Equal(t.modTimes[i]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,272 @@
+package listeners
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func TestTlsFiles_MutualTls(t *testing.T) {
+	dir := t.TempDir()
+
+	authority := newTestAuthority(t, "ca")
+
+	writeTestPair(t, dir, "server", authority, "127.0.0.1")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), authority.pem, 0600))
+
+	files, err := NewTlsFiles(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.pem"))
+
+	require.NoError(t, err)
+
+	address := serveTestTls(t, files.Config())
+
+	clientCertificate := makeTestPair(t, authority, "client")
+	strangerCertificate := makeTestPair(t, newTestAuthority(t, "other"), "client")
+
+	assert.NoError(t, handshake(address, authority, &clientCertificate))
+	assert.Error(t, handshake(address, authority, nil))
+	assert.Error(t, handshake(address, authority, &strangerCertificate))
+}
+
+func TestTlsFiles_Reload(t *testing.T) {
+	dir := t.TempDir()
+
+	first := newTestAuthority(t, "first")
+
+	writeTestPair(t, dir, "server", first, "127.0.0.1")
+
+	files, err := NewTlsFiles(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), "")
+
+	require.NoError(t, err)
+
+	files.checkInterval = 0
+
+	address := serveTestTls(t, files.Config())
+
+	require.NoError(t, handshake(address, first, nil))
+
+	second := newTestAuthority(t, "second")
+
+	writeTestPair(t, dir, "server", second, "127.0.0.1")
+
+	// the same second of the mod time is possible on coarse file systems
+	later := time.Now().Add(time.Minute)
+
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "server.pem"), later, later))
+
+	assert.NoError(t, handshake(address, second, nil))
+	assert.Error(t, handshake(address, first, nil))
+
+	// a broken change keeps the loaded certificate
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.pem"), []byte("broken"), 0600))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "server.pem"), later.Add(time.Minute), later.Add(time.Minute)))
+
+	assert.NoError(t, handshake(address, second, nil))
+}
+
+func TestDial_Tls(t *testing.T) {
+	dir := t.TempDir()
+
+	authority := newTestAuthority(t, "ca")
+
+	writeTestPair(t, dir, "server", authority, "127.0.0.1")
+	writeTestPair(t, dir, "client", authority)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), authority.pem, 0600))
+
+	files, err := NewTlsFiles(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.pem"))
+
+	require.NoError(t, err)
+
+	address := serveTestTls(t, WithNextProtos(files.Config(), "h2"))
+
+	config, err := NewClientTlsConfig(
+		filepath.Join(dir, "ca.pem"),
+		filepath.Join(dir, "client.pem"),
+		filepath.Join(dir, "client.key"),
+		"",
+	)
+
+	require.NoError(t, err)
+
+	config.NextProtos = []string{"h2"}
+
+	conn, err := Dial([]string{"tcp://" + address}, "", config)
+
+	require.NoError(t, err)
+
+	_, err = conn.Read(make([]byte, 1))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "h2", conn.(*tls.Conn).ConnectionState().NegotiatedProtocol)
+
+	_ = conn.Close()
+
+	withoutCertificate, err := NewClientTlsConfig(filepath.Join(dir, "ca.pem"), "", "", "")
+
+	require.NoError(t, err)
+
+	conn, err = Dial([]string{"tcp://" + address}, "", withoutCertificate)
+
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+
+		_ = conn.Close()
+	}
+
+	assert.Error(t, err)
+
+	_, err = NewClientTlsConfig("", filepath.Join(dir, "client.pem"), "", "")
+
+	assert.Error(t, err)
+}
+
+func serveTestTls(t *testing.T, config *tls.Config) string {
+	listener, err := Listen(Address{Network: "tcp", Address: "127.0.0.1:0"}, 0, config)
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_ = conn.(*tls.Conn).Handshake()
+
+				_, _ = conn.Write([]byte{1})
+
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// handshake reads a byte, with TLS 1.3 the client certificate is rejected after the client handshake
+func handshake(address string, authority *testAuthority, certificate *tls.Certificate) error {
+	roots := x509.NewCertPool()
+
+	roots.AddCert(authority.certificate)
+
+	config := &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+
+	if certificate != nil {
+		config.Certificates = []tls.Certificate{*certificate}
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", address, config)
+
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	_, err = conn.Read(make([]byte, 1))
+
+	return err
+}
+
+func newTestAuthority(t *testing.T, name string) *testAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+
+	require.NoError(t, err)
+
+	return &testAuthority{
+		certificate: certificate,
+		key:         key,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func makeTestPemPair(t *testing.T, authority *testAuthority, name string, ips ...string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, ip := range ips {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, authority.certificate, &key.PublicKey, authority.key)
+
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func makeTestPair(t *testing.T, authority *testAuthority, name string) tls.Certificate {
+	certPem, keyPem := makeTestPemPair(t, authority, name)
+
+	certificate, err := tls.X509KeyPair(certPem, keyPem)
+
+	require.NoError(t, err)
+
+	return certificate
+}
+
+func writeTestPair(t *testing.T, dir string, name string, authority *testAuthority, ips ...string) {
+	certPem, keyPem := makeTestPemPair(t, authority, name, ips...)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".pem"), certPem, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), keyPem, 0600))
+}
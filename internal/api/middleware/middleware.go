@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"time"
+)
+
+type Call struct {
+	RequestId     string
+	ServiceMethod string
+	StartedAt     time.Time
+	Error         string
+}
+
+type Middleware func(call *Call, next func())
+
+func NewCall(serviceMethod string, requestId string) *Call {
+	return &Call{
+		RequestId:     requestId,
+		ServiceMethod: serviceMethod,
+		StartedAt:     time.Now(),
+	}
+}
+
+// Chain composes the middlewares, the first one is the outermost
+func Chain(middlewares ...Middleware) Middleware {
+	return func(call *Call, next func()) {
+		run(middlewares, call, next)
+	}
+}
+
+func run(middlewares []Middleware, call *Call, next func()) {
+	if len(middlewares) == 0 {
+		next()
+
+		return
+	}
+
+	middlewares[0](call, func() {
+		run(middlewares[1:], call, next)
+	})
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"net"
+	"net/rpc"
+	"sparallel_server/internal/services/connections"
+	"testing"
+	"time"
+
+	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MiddlewareServer struct {
+	release chan struct{}
+}
+
+type MiddlewareArgs struct {
+	Action string
+}
+
+type MiddlewareReply struct {
+	Answer string
+}
+
+func (s *MiddlewareServer) Call(args *MiddlewareArgs, reply *MiddlewareReply) error {
+	switch args.Action {
+	case "panic":
+		panic("broken handler")
+	case "wait":
+		<-s.release
+	}
+
+	reply.Answer = args.Action
+
+	return nil
+}
+
+func TestChain(t *testing.T) {
+	var order []string
+
+	record := func(name string) Middleware {
+		return func(call *Call, next func()) {
+			order = append(order, name+":before")
+
+			next()
+
+			order = append(order, name+":after")
+		}
+	}
+
+	Chain(record("first"), record("second"))(NewCall("Server.Method", ""), func() {
+		order = append(order, "method")
+	})
+
+	assert.Equal(t, []string{"first:before", "second:before", "method", "second:after", "first:after"}, order)
+}
+
+func TestServeCodec(t *testing.T) {
+	server := &MiddlewareServer{release: make(chan struct{})}
+
+	rpcServer := rpc.NewServer()
+
+	require.NoError(t, rpcServer.Register(server))
+
+	tracker := connections.GetTracker()
+
+	before := tracker.Stats().Methods
+
+	serverConn, clientConn := net.Pipe()
+
+	go ServeCodec(rpcServer, goridgeRpc.NewCodec(serverConn), Chain(RequestId(), Metrics(tracker), Recover()))
+
+	client := rpc.NewClientWithCodec(goridgeRpc.NewClientCodec(clientConn))
+
+	defer client.Close()
+
+	var reply MiddlewareReply
+
+	err := client.Call("MiddlewareServer.Call", MiddlewareArgs{Action: "panic"}, &reply)
+
+	assert.ErrorContains(t, err, "panic: broken handler")
+
+	// a slow call doesn't block the next ones of the connection
+	slow := client.Go("MiddlewareServer.Call", MiddlewareArgs{Action: "wait"}, &MiddlewareReply{}, nil)
+
+	require.NoError(t, client.Call("MiddlewareServer.Call", MiddlewareArgs{Action: "echo"}, &reply))
+	assert.Equal(t, "echo", reply.Answer)
+
+	close(server.release)
+
+	select {
+	case call := <-slow.Done:
+		assert.NoError(t, call.Error)
+	case <-time.After(time.Second):
+		t.Fatal("slow call is not finished")
+	}
+
+	err = client.Call("UnknownServer.Call", MiddlewareArgs{}, &reply)
+
+	assert.ErrorContains(t, err, "can't find service")
+
+	// the tracker is shared by the runs of the test
+	after := tracker.Stats().Methods
+
+	assert.Equal(t, before["MiddlewareServer.Call"].Calls+3, after["MiddlewareServer.Call"].Calls)
+	assert.Equal(t, before["MiddlewareServer.Call"].Errors+1, after["MiddlewareServer.Call"].Errors)
+	assert.Equal(t, before["UnknownServer.Call"].Errors+1, after["UnknownServer.Call"].Errors)
+}
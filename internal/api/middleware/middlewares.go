@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sparallel_server/internal/services/connections"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func RequestId() Middleware {
+	return func(call *Call, next func()) {
+		if call.RequestId == "" {
+			call.RequestId = uuid.New().String()
+		}
+
+		next()
+	}
+}
+
+func AccessLog() Middleware {
+	return func(call *Call, next func()) {
+		next()
+
+		message := "Rpc call [" + call.ServiceMethod + "] [" + call.RequestId + "] " +
+			"took " + time.Since(call.StartedAt).String()
+
+		if call.Error != "" {
+			slog.Error(message + ": " + call.Error)
+
+			return
+		}
+
+		slog.Info(message)
+	}
+}
+
+func Metrics(tracker *connections.Tracker) Middleware {
+	return func(call *Call, next func()) {
+		next()
+
+		tracker.CountCall(call.ServiceMethod, call.Error != "")
+	}
+}
+
+func Recover() Middleware {
+	return func(call *Call, next func()) {
+		defer func() {
+			recovered := recover()
+
+			if recovered == nil {
+				return
+			}
+
+			call.Error = fmt.Sprintf("panic: %v", recovered)
+
+			slog.Error("Rpc call [" + call.ServiceMethod + "] [" + call.RequestId + "] " +
+				call.Error + "\n" + string(debug.Stack()))
+		}()
+
+		next()
+	}
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/rpc"
+	"sync"
+)
+
+const internalError = "internal error"
+
+// ServeCodec serves the connection like [server].ServeCodec. Every call runs in its goroutine through [middleware],
+// so a panic of a method can be recovered there. The requests are still read one by one.
+// The response is written after the middleware, so the call is accounted before the client gets it.
+func ServeCodec(server *rpc.Server, codec rpc.ServerCodec, middleware Middleware) {
+	writing := &sync.Mutex{}
+	calls := &sync.WaitGroup{}
+
+	for {
+		var request rpc.Request
+
+		err := codec.ReadRequestHeader(&request)
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				slog.Debug("Rpc connection is closed: " + err.Error())
+			}
+
+			break
+		}
+
+		call := &callCodec{
+			codec:    codec,
+			request:  request,
+			call:     NewCall(request.ServiceMethod, ""),
+			writing:  writing,
+			bodyRead: make(chan struct{}),
+		}
+
+		calls.Add(1)
+
+		go func() {
+			defer calls.Done()
+
+			call.serve(server, middleware)
+		}()
+
+		// the codec reads the next request after the body of the current one
+		<-call.bodyRead
+	}
+
+	calls.Wait()
+
+	_ = codec.Close()
+}
+
+type callCodec struct {
+	codec   rpc.ServerCodec
+	request rpc.Request
+	call    *Call
+
+	writing  *sync.Mutex
+	response *rpc.Response
+	body     any
+
+	headerRead   bool
+	bodyRead     chan struct{}
+	bodyReadOnce sync.Once
+}
+
+func (c *callCodec) serve(server *rpc.Server, middleware Middleware) {
+	defer c.markBodyRead()
+
+	middleware(c.call, func() {
+		_ = server.ServeRequest(c)
+	})
+
+	if c.response == nil {
+		// the method panicked, the error is set by Recover
+		message := c.call.Error
+
+		if message == "" {
+			message = internalError
+		}
+
+		c.response = &rpc.Response{ServiceMethod: c.request.ServiceMethod, Seq: c.request.Seq, Error: message}
+		c.body = struct{}{}
+	}
+
+	c.writing.Lock()
+	defer c.writing.Unlock()
+
+	_ = c.codec.WriteResponse(c.response, c.body)
+}
+
+func (c *callCodec) ReadRequestHeader(request *rpc.Request) error {
+	if c.headerRead {
+		return io.EOF
+	}
+
+	c.headerRead = true
+
+	request.ServiceMethod = c.request.ServiceMethod
+	request.Seq = c.request.Seq
+
+	return nil
+}
+
+func (c *callCodec) ReadRequestBody(args any) error {
+	defer c.markBodyRead()
+
+	return c.codec.ReadRequestBody(args)
+}
+
+// WriteResponse keeps the response for serve, net/rpc reuses [response] after the call
+func (c *callCodec) WriteResponse(response *rpc.Response, body any) error {
+	kept := *response
+
+	c.response = &kept
+	c.body = body
+	c.call.Error = response.Error
+
+	return nil
+}
+
+func (c *callCodec) Close() error {
+	return nil
+}
+
+func (c *callCodec) markBodyRead() {
+	c.bodyReadOnce.Do(func() {
+		close(c.bodyRead)
+	})
+}
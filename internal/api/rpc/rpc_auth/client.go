@@ -0,0 +1,50 @@
+package rpc_auth
+
+import (
+	"crypto/tls"
+	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
+	"net/rpc"
+	"sparallel_server/internal/api/listeners"
+	"sparallel_server/internal/config"
+)
+
+func Dial(port string) (*rpc.Client, error) {
+	cfg := config.GetConfig()
+
+	tlsConfig, err := ClientTlsConfig()
+
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := listeners.Dial(cfg.GetRpcListen(), port, tlsConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := rpc.NewClientWithCodec(goridgeRpc.NewClientCodec(conn))
+
+	if err = Authenticate(client, cfg.GetRpcClientToken()); err != nil {
+		_ = client.Close()
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func ClientTlsConfig() (*tls.Config, error) {
+	cfg := config.GetConfig()
+
+	if !cfg.IsRpcClientTls() {
+		return nil, nil
+	}
+
+	return listeners.NewClientTlsConfig(
+		cfg.GetRpcClientTlsCaFile(),
+		cfg.GetRpcClientTlsCertFile(),
+		cfg.GetRpcClientTlsKeyFile(),
+		cfg.GetRpcClientTlsServerName(),
+	)
+}
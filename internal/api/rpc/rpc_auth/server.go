@@ -0,0 +1,58 @@
+package rpc_auth
+
+import (
+	"log/slog"
+	"net/rpc"
+	"sparallel_server/pkg/foundation/errs"
+)
+
+type AuthServer struct {
+}
+
+func NewServer() *AuthServer {
+	return &AuthServer{}
+}
+
+type AuthenticateArgs struct {
+	Token string
+}
+
+func (a *AuthenticateArgs) GetToken() string {
+	return a.Token
+}
+
+type AuthenticateResult struct {
+	Answer string
+}
+
+// Authenticate authenticates the goridge connection. The token is checked by the connection codec,
+// so the call just answers when the acl is enabled and succeeds anyway when it is disabled.
+func (s *AuthServer) Authenticate(_ *AuthenticateArgs, reply *AuthenticateResult) error {
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func (s *AuthServer) Pause() error {
+	return nil
+}
+
+func (s *AuthServer) UnPause() error {
+	return nil
+}
+
+func (s *AuthServer) Close() error {
+	slog.Warn("Closing auth server")
+
+	return nil
+}
+
+func Authenticate(client *rpc.Client, token string) error {
+	if token == "" {
+		return nil
+	}
+
+	var reply AuthenticateResult
+
+	return errs.Err(client.Call("AuthServer.Authenticate", AuthenticateArgs{Token: token}, &reply))
+}
@@ -31,3 +31,39 @@ type StatsArgs struct {
 type StatsResult struct {
 	Json string
 }
+
+type SetRateLimitArgs struct {
+	Scope string
+	Key   string
+	Rate  float64
+	Burst int
+}
+
+type SetRateLimitResult struct {
+	Answer string
+}
+
+type SetChaosFaultArgs struct {
+	Fault string
+	Rate  float64
+}
+
+type SetChaosFaultResult struct {
+	Answer string
+}
+
+type ChaosStatsArgs struct {
+	Message string
+}
+
+type ChaosStatsResult struct {
+	Json string
+}
+
+type CapabilitiesArgs struct {
+	Message string
+}
+
+type CapabilitiesResult struct {
+	Json string
+}
@@ -3,7 +3,10 @@ package rpc_manager
 import (
 	"encoding/json"
 	"log/slog"
+	"sparallel_server/internal/services/capabilities"
 	"sparallel_server/internal/services/stats_service"
+	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/pkg/foundation/errs"
 	"sync"
 	"syscall"
 	"time"
@@ -80,6 +83,73 @@ func (s *ManagerServer) Stats(_ *StatsArgs, reply *StatsResult) error {
 	return nil
 }
 
+func (s *ManagerServer) SetRateLimit(args *SetRateLimitArgs, reply *SetRateLimitResult) error {
+	workersService := workers_server.GetService()
+
+	if workersService == nil {
+		return workers_server.ErrNotServed
+	}
+
+	err := workersService.SetRateLimit(args.Scope, args.Key, args.Rate, args.Burst)
+
+	if err != nil {
+		return err
+	}
+
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func (s *ManagerServer) SetChaosFault(args *SetChaosFaultArgs, reply *SetChaosFaultResult) error {
+	workersService := workers_server.GetService()
+
+	if workersService == nil {
+		return workers_server.ErrNotServed
+	}
+
+	err := workersService.SetChaosFault(args.Fault, args.Rate)
+
+	if err != nil {
+		return err
+	}
+
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func (s *ManagerServer) ChaosStats(_ *ChaosStatsArgs, reply *ChaosStatsResult) error {
+	workersService := workers_server.GetService()
+
+	if workersService == nil {
+		return workers_server.ErrNotServed
+	}
+
+	data, err := json.Marshal(workersService.GetChaosStats())
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	reply.Json = string(data)
+
+	return nil
+}
+
+// Capabilities describes the served methods, so the clients can negotiate instead of failing on a missing method
+func (s *ManagerServer) Capabilities(_ *CapabilitiesArgs, reply *CapabilitiesResult) error {
+	data, err := json.Marshal(capabilities.GetRegistry().Get())
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	reply.Json = string(data)
+
+	return nil
+}
+
 func (s *ManagerServer) Pause() error {
 	return nil
 }
@@ -1,42 +1,60 @@
-package rpc_ping_pong
+package rpc_ping_pong_test
 
 import (
-	"github.com/joho/godotenv"
-	"github.com/stretchr/testify/assert"
-	"net/rpc"
+	"fmt"
+	"log/slog"
 	"os"
+	"sparallel_server/internal/api/rpc/rpc_ping_pong"
+	"sparallel_server/internal/e2e"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func init() {
-	if err := godotenv.Load("../../../.env"); err != nil {
-		panic(err)
+var harness *e2e.Harness
+
+func TestMain(m *testing.M) {
+	var err error
+
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	harness, err = e2e.Start(map[string]string{
+		"SERVE_WORKERS": "false",
+	})
+
+	if err != nil {
+		fmt.Println(err.Error())
+
+		os.Exit(1)
 	}
 
-	// TODO: validate env variables
+	code := m.Run()
+
+	_ = harness.Close()
+
+	os.Exit(code)
 }
 
 func TestPingPong_Ping(t *testing.T) {
-	rpcPort := os.Getenv("RPC_PORT")
-
-	client, err := rpc.Dial("tcp", ":"+rpcPort)
+	client, err := harness.Client()
 
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
-	defer func(client *rpc.Client) {
+	defer func() {
 		err := client.Close()
 
 		assert.NoError(t, err)
-	}(client)
+	}()
 
 	message := time.Now().String()
 
-	args := PingArgs{
+	args := rpc_ping_pong.PingArgs{
 		Message: message,
 	}
 
-	var result PingResult
+	var result rpc_ping_pong.PingResult
 
 	err = client.Call("PingPongServer.Ping", args, &result)
 
@@ -11,6 +11,14 @@ type AggregateArgs struct {
 	Pipeline   string
 }
 
+func (a *AggregateArgs) GetConnection() string {
+	return a.Connection
+}
+
+func (a *AggregateArgs) GetDatabase() string {
+	return a.Database
+}
+
 type AggregateReply struct {
 	Error         string
 	OperationUuid string
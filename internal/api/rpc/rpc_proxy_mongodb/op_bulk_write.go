@@ -9,6 +9,14 @@ type BulkWriteArgs struct {
 	Models     string
 }
 
+func (a *BulkWriteArgs) GetConnection() string {
+	return a.Connection
+}
+
+func (a *BulkWriteArgs) GetDatabase() string {
+	return a.Database
+}
+
 type BulkWriteReply struct {
 	Error         string
 	OperationUuid string
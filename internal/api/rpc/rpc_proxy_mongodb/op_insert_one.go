@@ -9,6 +9,14 @@ type InsertOneArgs struct {
 	Document   string
 }
 
+func (a *InsertOneArgs) GetConnection() string {
+	return a.Connection
+}
+
+func (a *InsertOneArgs) GetDatabase() string {
+	return a.Database
+}
+
 type InsertOneReply struct {
 	Error         string
 	OperationUuid string
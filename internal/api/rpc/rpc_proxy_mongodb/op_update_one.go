@@ -13,6 +13,14 @@ type UpdateOneArgs struct {
 	OpUpsert   bool
 }
 
+func (a *UpdateOneArgs) GetConnection() string {
+	return a.Connection
+}
+
+func (a *UpdateOneArgs) GetDatabase() string {
+	return a.Database
+}
+
 type UpdateOneReply struct {
 	Error         string
 	OperationUuid string
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
@@ -9,14 +10,23 @@ import (
 	"net"
 	"net/rpc"
 	"os"
-	"sparallel_server/internal/api/rpc/rpc_manager"
-	"sparallel_server/internal/api/rpc/rpc_ping_pong"
-	"sparallel_server/internal/api/rpc/rpc_proxy_mongodb"
-	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/grpc_api"
+	"sparallel_server/internal/api/http_gateway"
+	"sparallel_server/internal/api/listeners"
+	"sparallel_server/internal/api/middleware"
+	"sparallel_server/internal/api/rpc/rpc_auth"
+	"sparallel_server/internal/api/subscription"
 	"sparallel_server/internal/config"
+	"sparallel_server/internal/services/capabilities"
+	"sparallel_server/internal/services/connections"
+	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/pkg/foundation/app"
 	"sparallel_server/pkg/foundation/errs"
+	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 var server *Server
@@ -24,11 +34,17 @@ var once sync.Once
 
 type Server struct {
 	rpcPort      string
-	listener     net.Listener
+	listeners    []net.Listener
 	servers      []ServerInterface
+	subscription *subscription.Server
+	httpGateway  *http_gateway.Server
+	grpcServer   *grpc_api.Server
+	acl          *auth.ACL
+	middleware   middleware.Middleware
 	pausingMutex sync.Mutex
 	closing      atomic.Bool
-	closed       atomic.Bool
+	closed       chan struct{}
+	pidFilePath  string // set once the pid file is created, so a failed start keeps the one of a running server
 	config       *config.Config
 }
 
@@ -37,6 +53,13 @@ func NewServer(rpcPort string) *Server {
 		server = &Server{
 			rpcPort: rpcPort,
 			config:  config.GetConfig(),
+			closed:  make(chan struct{}),
+			middleware: middleware.Chain(
+				middleware.RequestId(),
+				middleware.AccessLog(),
+				middleware.Metrics(connections.GetTracker()),
+				middleware.Recover(),
+			),
 		}
 	})
 
@@ -44,13 +67,35 @@ func NewServer(rpcPort string) *Server {
 }
 
 func (s *Server) Run(ctx context.Context) error {
-	listener, err := net.Listen("tcp", ":"+s.rpcPort)
+	acl, err := s.loadAcl()
 
 	if err != nil {
-		return errs.Err(err)
+		return err
 	}
 
-	s.listener = listener
+	s.acl = acl
+
+	addresses, err := listeners.Resolve(s.config.GetRpcListen(), s.rpcPort)
+
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := s.makeTlsConfig()
+
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		listener, err := listeners.Listen(address, s.config.GetRpcSocketPermissions(), tlsConfig)
+
+		if err != nil {
+			return s.abort(err)
+		}
+
+		s.listeners = append(s.listeners, listener)
+	}
 
 	for _, srv := range s.detectServers(ctx) {
 		err = rpc.Register(srv)
@@ -58,31 +103,125 @@ func (s *Server) Run(ctx context.Context) error {
 		if err != nil {
 			slog.Error(err.Error())
 
-			return errs.Err(err)
+			return s.abort(errs.Err(err))
 		}
 
+		capabilities.GetRegistry().AddServer(srv)
+
 		s.servers = append(s.servers, srv)
 	}
 
+	if s.config.IsServeWorkers() && s.config.GetSubscriptionPort() != "" {
+		subscriptionServer := subscription.NewServer(
+			listeners.ResolveSiblings(addresses, s.config.GetSubscriptionPort()),
+			s.config.GetRpcSocketPermissions(),
+			tlsConfig,
+			s.acl,
+			workers_server.GetService(),
+		)
+
+		if err = subscriptionServer.Listen(); err != nil {
+			return s.abort(err)
+		}
+
+		s.subscription = subscriptionServer
+
+		go func() {
+			err := s.subscription.Serve()
+
+			if err != nil {
+				slog.Error("Subscriptions server failed: " + err.Error())
+			}
+		}()
+	}
+
+	if s.config.GetHttpPort() != "" {
+		httpGateway := http_gateway.NewServer(
+			listeners.ResolveSiblings(addresses, s.config.GetHttpPort()),
+			s.config.GetRpcSocketPermissions(),
+			tlsConfig,
+			s.acl,
+			s.middleware,
+		)
+
+		if err = httpGateway.Listen(); err != nil {
+			return s.abort(err)
+		}
+
+		s.httpGateway = httpGateway
+
+		go func() {
+			err := s.httpGateway.Serve()
+
+			if err != nil {
+				slog.Error("HTTP gateway failed: " + err.Error())
+			}
+		}()
+	}
+
+	if s.config.GetGrpcPort() != "" {
+		grpcServer := grpc_api.NewServer(
+			listeners.ResolveSiblings(addresses, s.config.GetGrpcPort()),
+			s.config.GetRpcSocketPermissions(),
+			tlsConfig,
+			s.acl,
+			s.middleware,
+		)
+
+		for _, srv := range s.servers {
+			grpcServer.Register(srv)
+		}
+
+		if err = grpcServer.Listen(); err != nil {
+			return s.abort(err)
+		}
+
+		s.grpcServer = grpcServer
+
+		go func() {
+			err := s.grpcServer.Serve()
+
+			if err != nil {
+				slog.Error("gRPC server failed: " + err.Error())
+			}
+		}()
+	}
+
 	pidFilePath := s.config.GetServerPidFilePath()
 
 	if pidFilePath != "" {
 		pid := os.Getpid()
 		err = os.WriteFile(pidFilePath, []byte(fmt.Sprint(pid)), 0644)
 		if err != nil {
-			return errs.Err(err)
+			return s.abort(errs.Err(err))
 		}
 
+		s.pidFilePath = pidFilePath
+
 		slog.Warn("Pid file created: " + pidFilePath)
 	}
 
-	slog.Info("Listening on port " + s.rpcPort)
+	for i, listener := range s.listeners {
+		slog.Info("Listening on " + addresses[i].String())
+
+		go s.accept(listener)
+	}
 
+	<-s.closed
+
+	return nil
+}
+
+func (s *Server) accept(listener net.Listener) {
 	for {
-		conn, err := s.listener.Accept()
+		conn, err := listener.Accept()
 
 		if s.closing.Load() {
-			break
+			if conn != nil {
+				_ = conn.Close()
+			}
+
+			return
 		}
 
 		if err != nil {
@@ -91,14 +230,14 @@ func (s *Server) Run(ctx context.Context) error {
 			continue
 		}
 
-		go rpc.ServeCodec(goridgeRpc.NewCodec(conn))
-	}
+		var codec rpc.ServerCodec = goridgeRpc.NewCodec(conn)
 
-	for !s.closed.Load() {
-		//
-	}
+		if s.acl != nil {
+			codec = auth.NewCodec(codec, s.acl)
+		}
 
-	return nil
+		go middleware.ServeCodec(rpc.DefaultServer, connections.GetTracker().Track(conn, codec), s.middleware)
+	}
 }
 
 func (s *Server) GetServers() []ServerInterface {
@@ -142,60 +281,151 @@ func (s *Server) UnPause() error {
 }
 
 func (s *Server) Close() error {
+	if !s.closing.CompareAndSwap(false, true) {
+		return nil
+	}
+
 	slog.Warn("Closing rpc server...")
 
-	s.closing.Store(true)
+	errList := s.closeListeners()
 
-	var errList []error
+	tracker := connections.GetTracker()
+
+	tracker.Drain()
 
-	for _, server := range s.servers {
-		err := server.Close()
+	if s.subscription != nil {
+		err := s.subscription.Close()
 
 		if err != nil {
 			errList = append(errList, err)
 		}
 	}
 
-	if s.listener != nil {
-		err := errs.Err(s.listener.Close())
+	if s.httpGateway != nil {
+		err := s.httpGateway.Close()
 
 		if err != nil {
 			errList = append(errList, err)
 		}
 	}
 
-	pidFilePath := s.config.GetServerPidFilePath()
+	if s.grpcServer != nil {
+		err := s.grpcServer.Close()
 
-	if pidFilePath != "" {
-		_, err := os.Stat(pidFilePath)
+		if err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	timeout := time.Duration(s.config.GetRpcShutdownTimeoutSeconds()) * time.Second
+
+	if !tracker.WaitIdle(timeout) {
+		slog.Warn("Rpc calls are cut off after " + timeout.String() + ": " + strconv.FormatInt(tracker.Stats().InFlight, 10))
+	}
+
+	tracker.CloseAll()
+
+	for i := len(s.servers) - 1; i >= 0; i-- {
+		err := s.servers[i].Close()
+
+		if err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	if s.pidFilePath != "" {
+		_, err := os.Stat(s.pidFilePath)
 
 		if err == nil {
-			_ = os.Remove(pidFilePath)
+			_ = os.Remove(s.pidFilePath)
 
-			slog.Warn("Pid file deleted: " + pidFilePath)
+			slog.Warn("Pid file deleted: " + s.pidFilePath)
 		}
 	}
 
-	s.closed.Store(true)
+	close(s.closed)
 
 	return errs.Err(joinErrors(errList))
 }
 
+func (s *Server) abort(err error) error {
+	if closeErr := s.Close(); closeErr != nil {
+		slog.Error("Closing rpc server after a failed start: " + closeErr.Error())
+	}
+
+	return err
+}
+
 func (s *Server) detectServers(ctx context.Context) []ServerInterface {
+	// the auth codec relies on the auth server, so it is not left to the providers
 	servers := []ServerInterface{
-		rpc_ping_pong.NewServer(),
-		rpc_manager.NewServer(),
+		rpc_auth.NewServer(),
 	}
 
-	if s.config.IsServeWorkers() {
-		servers = append(servers, rpc_workers.NewServer(ctx))
+	for _, factory := range app.GetRegistry().GetRpcServers() {
+		if srv := factory(ctx); srv != nil {
+			servers = append(servers, srv)
+		}
 	}
 
-	if s.config.IsServeProxy() {
-		servers = append(servers, rpc_proxy_mongodb.NewServer(ctx))
+	return servers
+}
+
+func (s *Server) loadAcl() (*auth.ACL, error) {
+	aclFile := s.config.GetRpcAclFile()
+
+	if aclFile == "" {
+		return nil, nil
 	}
 
-	return servers
+	acl, err := auth.LoadACL(aclFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Warn("Rpc calls are authorized by acl [" + aclFile + "]")
+
+	return acl, nil
+}
+
+func (s *Server) makeTlsConfig() (*tls.Config, error) {
+	certFile := s.config.GetRpcTlsCertFile()
+	keyFile := s.config.GetRpcTlsKeyFile()
+
+	if certFile == "" && keyFile == "" {
+		if s.config.GetRpcTlsClientCaFile() != "" {
+			return nil, errs.Err(errors.New("client CA file requires the TLS certificate and key"))
+		}
+
+		return nil, nil
+	}
+
+	if certFile == "" || keyFile == "" {
+		return nil, errs.Err(errors.New("both TLS certificate and key files are required"))
+	}
+
+	tlsFiles, err := listeners.NewTlsFiles(certFile, keyFile, s.config.GetRpcTlsClientCaFile())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tlsFiles.Config(), nil
+}
+
+func (s *Server) closeListeners() []error {
+	var errList []error
+
+	for _, listener := range s.listeners {
+		err := errs.Err(listener.Close())
+
+		if err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	return errList
 }
 
 func joinErrors(errList []error) error {
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sparallel_server/internal/api/middleware"
+	"sparallel_server/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_RunClosesOpenedOnFailedStart(t *testing.T) {
+	occupied, err := net.Listen("tcp", "127.0.0.1:0")
+
+	require.NoError(t, err)
+
+	defer func() {
+		_ = occupied.Close()
+	}()
+
+	_, grpcPort, _ := net.SplitHostPort(occupied.Addr().String())
+
+	rpcPort, httpPort := freePort(t), freePort(t)
+
+	t.Setenv("RPC_LISTEN", "tcp://127.0.0.1:"+rpcPort)
+	t.Setenv("HTTP_PORT", httpPort)
+	t.Setenv("GRPC_PORT", grpcPort)
+
+	server := &Server{
+		rpcPort:    rpcPort,
+		config:     config.GetConfig(),
+		closed:     make(chan struct{}),
+		middleware: middleware.Chain(),
+	}
+
+	assert.Error(t, server.Run(context.Background()), "the gRPC port is in use")
+
+	// the rpc listener and the HTTP gateway opened before are released
+	for _, port := range []string{rpcPort, httpPort} {
+		listener, err := net.Listen("tcp", "127.0.0.1:"+port)
+
+		require.NoError(t, err, port)
+
+		_ = listener.Close()
+	}
+
+	select {
+	case <-server.closed:
+	default:
+		t.Fatal("the server is not closed")
+	}
+}
+
+func freePort(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	require.NoError(t, err)
+
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+
+	_ = listener.Close()
+
+	return port
+}
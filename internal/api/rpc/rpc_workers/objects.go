@@ -9,10 +9,20 @@ type ReloadResult struct {
 }
 
 type AddTaskArgs struct {
-	GroupUuid   string
-	TaskUuid    string
-	UnixTimeout int
-	Payload     string
+	GroupUuid          string
+	TaskUuid           string
+	TenantId           string
+	UnixTimeout        int
+	Payload            string
+	DependsOn          []string
+	InjectDependencies bool
+	RequiredTags       []string
+	RoutingKey         string
+	GroupRateLimit     float64 // tasks per second of the group unless ManagerServer.SetRateLimit set it
+	GroupRateBurst     int
+	WaitingTtl         int    // seconds the tasks of the group may wait after their timeout, 0 - WORKERS_WAITING_TTL, set once per group
+	ResultTtl          int    // seconds the results of the group are kept uncollected, 0 - WORKERS_RESULT_TTL, set once per group
+	Callback           string // http(s) URL or unix:///path/to.sock, the result is posted there when finished
 }
 
 type AddTaskResult struct {
@@ -29,6 +39,7 @@ type DetectFinishedTaskResult struct {
 	IsFinished bool
 	Response   string
 	IsError    bool
+	IsTimeout  bool
 }
 
 type CancelGroupArgs struct {
@@ -38,3 +49,44 @@ type CancelGroupArgs struct {
 type CancelGroupResult struct {
 	GroupUuid string
 }
+
+type GetTaskEventsArgs struct {
+	TaskUuid string
+}
+
+type GetTaskEventsResult struct {
+	TaskUuid string
+	Events   []TaskEvent
+}
+
+type TaskEvent struct {
+	Time      string
+	GroupUuid string
+	TaskUuid  string
+	Type      string
+	Pid       int
+	Message   string
+}
+
+type GetCallbackStatusArgs struct {
+	TaskUuid string
+}
+
+type GetCallbackStatusResult struct {
+	TaskUuid  string
+	Exists    bool
+	Target    string
+	State     string
+	Attempts  int
+	LastError string
+	UpdatedAt string
+}
+
+type AcknowledgeTaskArgs struct {
+	GroupUuid string
+	TaskUuid  string
+}
+
+type AcknowledgeTaskResult struct {
+	Acknowledged bool
+}
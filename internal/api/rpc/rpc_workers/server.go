@@ -6,14 +6,21 @@ import (
 	"log/slog"
 	"sparallel_server/internal/config"
 	"sparallel_server/internal/services/workers_server"
-	"sparallel_server/pkg/foundation/errs"
+	"sparallel_server/internal/services/workers_server/callbacks"
+	"sparallel_server/internal/services/workers_server/chaos"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sparallel_server/internal/services/workers_server/subscriptions"
+	"sparallel_server/internal/services/workers_server/tasks"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 var server *WorkersServer
 var once sync.Once
 
+var ErrPausing = errors.New("workers server is pausing")
+
 type WorkersServer struct {
 	service *workers_server.Service
 	pausing atomic.Bool
@@ -30,6 +37,27 @@ func NewServer(ctx context.Context) *WorkersServer {
 			cfg.GetWorkersNumberScaleUp(),
 			cfg.GetWorkersNumberPercentScaleUp(),
 			cfg.GetWorkersNumberPercentScaleDown(),
+			cfg.GetWorkersDispatchMode(),
+			cfg.GetWorkersEventsLimit(),
+			cfg.GetWorkersEventsFilePath(),
+			payloads.NewPacker(
+				cfg.GetPayloadCompressThreshold(),
+				cfg.GetPayloadSpillThreshold(),
+				cfg.GetPayloadSpillDir(),
+			),
+			callbacks.NewCallbacks(
+				callbacks.NewTargets(cfg.GetCallbackAllowedHosts(), cfg.GetCallbackSocketDir()),
+				cfg.GetCallbackSecret(),
+				cfg.GetCallbackMaxAttempts(),
+				time.Duration(cfg.GetCallbackBackoffMs())*time.Millisecond,
+				time.Duration(cfg.GetCallbackTimeoutSeconds())*time.Second,
+				cfg.GetCallbackWorkersNumber(),
+				cfg.GetCallbackQueueSize(),
+			),
+			chaos.NewChaos(
+				cfg.IsChaosMode(),
+				time.Duration(cfg.GetChaosDispatchDelayMs())*time.Millisecond,
+			),
 		)
 
 		service.Start(ctx)
@@ -44,7 +72,7 @@ func NewServer(ctx context.Context) *WorkersServer {
 
 func (s *WorkersServer) Reload(args *ReloadArgs, reply *ReloadResult) error {
 	if s.pausing.Load() {
-		return errs.Err(errors.New("server is pausing"))
+		return ErrPausing
 	}
 
 	s.service.Reload(args.Message)
@@ -56,14 +84,25 @@ func (s *WorkersServer) Reload(args *ReloadArgs, reply *ReloadResult) error {
 
 func (s *WorkersServer) AddTask(args *AddTaskArgs, reply *AddTaskResult) error {
 	if s.pausing.Load() {
-		err := errors.New("workers server is pausing")
-
-		slog.Error("error at task adding: " + err.Error())
-
-		return errs.Err(err)
+		return ErrPausing
 	}
 
-	task, err := s.service.AddTask(args.GroupUuid, args.TaskUuid, args.UnixTimeout, args.Payload)
+	task, err := s.service.AddTask(&tasks.AddTaskArgs{
+		GroupUuid:          args.GroupUuid,
+		TaskUuid:           args.TaskUuid,
+		TenantId:           args.TenantId,
+		UnixTimeout:        args.UnixTimeout,
+		Payload:            args.Payload,
+		DependsOn:          args.DependsOn,
+		InjectDependencies: args.InjectDependencies,
+		RequiredTags:       args.RequiredTags,
+		RoutingKey:         args.RoutingKey,
+		GroupRateLimit:     args.GroupRateLimit,
+		GroupRateBurst:     args.GroupRateBurst,
+		WaitingTtl:         args.WaitingTtl,
+		ResultTtl:          args.ResultTtl,
+		Callback:           args.Callback,
+	})
 
 	if err != nil {
 		return err
@@ -82,6 +121,7 @@ func (s *WorkersServer) DetectAnyFinishedTask(args *DetectFinishedTaskArgs, repl
 	reply.IsFinished = response.IsFinished
 	reply.Response = response.Response
 	reply.IsError = response.IsError
+	reply.IsTimeout = response.TimedOut
 
 	return nil
 }
@@ -94,6 +134,65 @@ func (s *WorkersServer) CancelGroup(args *CancelGroupArgs, reply *CancelGroupRes
 	return nil
 }
 
+func (s *WorkersServer) GetTaskEvents(args *GetTaskEventsArgs, reply *GetTaskEventsResult) error {
+	reply.TaskUuid = args.TaskUuid
+
+	for _, event := range s.service.GetTaskEvents(args.TaskUuid) {
+		reply.Events = append(reply.Events, TaskEvent{
+			Time:      event.Time.Format(time.RFC3339Nano),
+			GroupUuid: event.GroupUuid,
+			TaskUuid:  event.TaskUuid,
+			Type:      string(event.Type),
+			Pid:       event.Pid,
+			Message:   event.Message,
+		})
+	}
+
+	return nil
+}
+
+func (s *WorkersServer) GetCallbackStatus(args *GetCallbackStatusArgs, reply *GetCallbackStatusResult) error {
+	reply.TaskUuid = args.TaskUuid
+
+	status := s.service.GetCallbackStatus(args.TaskUuid)
+
+	if status == nil {
+		return nil
+	}
+
+	reply.Exists = true
+	reply.Target = status.Target
+	reply.State = string(status.State)
+	reply.Attempts = status.Attempts
+	reply.LastError = status.LastError
+	reply.UpdatedAt = status.UpdatedAt.Format(time.RFC3339Nano)
+
+	return nil
+}
+
+// WatchFinishedTasks subscribes to the finished tasks of the groups, UnwatchFinishedTasks ends the subscription
+func (s *WorkersServer) WatchFinishedTasks(groupUuids []string) (*subscriptions.Subscriber, error) {
+	if err := s.checkServing(); err != nil {
+		return nil, err
+	}
+
+	return s.service.Subscribe(groupUuids), nil
+}
+
+func (s *WorkersServer) UnwatchFinishedTasks(subscriber *subscriptions.Subscriber) {
+	s.service.Unsubscribe(subscriber)
+}
+
+func (s *WorkersServer) AcknowledgeTask(args *AcknowledgeTaskArgs, reply *AcknowledgeTaskResult) error {
+	if err := s.checkServing(); err != nil {
+		return err
+	}
+
+	reply.Acknowledged = s.service.AcknowledgeTask(args.GroupUuid, args.TaskUuid)
+
+	return nil
+}
+
 func (s *WorkersServer) Pause() error {
 	s.pausing.Store(true)
 
@@ -112,6 +211,18 @@ func (s *WorkersServer) UnPause() error {
 	return nil
 }
 
+func (s *WorkersServer) checkServing() error {
+	if s.pausing.Load() {
+		return ErrPausing
+	}
+
+	if s.service.IsClosing() {
+		return workers_server.ErrClosing
+	}
+
+	return nil
+}
+
 func (s *WorkersServer) Close() error {
 	slog.Warn("Closing workers server")
 
@@ -0,0 +1,35 @@
+package subscription
+
+const (
+	ActionAuthenticate = "authenticate"
+	ActionSubscribe    = "subscribe"
+	ActionAck          = "ack"
+)
+
+const (
+	TypeAuthenticated = "authenticated"
+	TypeSubscribed    = "subscribed"
+	TypeTask          = "task"
+	TypeAcked         = "acked"
+	TypeError         = "error"
+)
+
+type Request struct {
+	Action     string
+	Token      string
+	GroupUuids []string
+	GroupUuid  string
+	TaskUuid   string
+}
+
+type Message struct {
+	Type         string
+	GroupUuids   []string `json:",omitempty"`
+	GroupUuid    string   `json:",omitempty"`
+	TaskUuid     string   `json:",omitempty"`
+	IsError      bool     `json:",omitempty"`
+	IsTimeout    bool     `json:",omitempty"`
+	Response     string   `json:",omitempty"`
+	Acknowledged bool     `json:",omitempty"`
+	Message      string   `json:",omitempty"`
+}
@@ -0,0 +1,327 @@
+package subscription
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/listeners"
+	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sparallel_server/internal/services/workers_server/subscriptions"
+	"sparallel_server/pkg/foundation/errs"
+	"sync"
+	"sync/atomic"
+)
+
+const maxRequestSize = 1024 * 1024
+
+// the acl patterns are shared with the goridge and gRPC calls of the same meaning
+const (
+	subscribeMethod = "WorkersServer.WatchFinishedTasks"
+	ackMethod       = "WorkersServer.AcknowledgeTask"
+)
+
+// Server pushes finished tasks to clients over long-lived connections of JSON lines.
+// A client subscribes with {"Action":"subscribe","GroupUuids":[...]} and acknowledges
+// every received task of the subscribed groups with {"Action":"ack","GroupUuid":"...","TaskUuid":"..."}.
+// With an acl the client authenticates by {"Action":"authenticate","Token":"..."} first.
+// Unacknowledged tasks and the ones missed by a slow subscriber stay collectable by polling.
+type Server struct {
+	addresses   []listeners.Address
+	permissions os.FileMode
+	tlsConfig   *tls.Config
+	acl         *auth.ACL
+	service     *workers_server.Service
+	listeners   []net.Listener
+	closing     atomic.Bool
+
+	mutex       sync.Mutex
+	connections map[net.Conn]bool
+}
+
+func NewServer(
+	addresses []listeners.Address,
+	permissions os.FileMode,
+	tlsConfig *tls.Config,
+	acl *auth.ACL,
+	service *workers_server.Service,
+) *Server {
+	return &Server{
+		addresses:   addresses,
+		permissions: permissions,
+		tlsConfig:   tlsConfig,
+		acl:         acl,
+		service:     service,
+		connections: make(map[net.Conn]bool),
+	}
+}
+
+func (s *Server) Listen() error {
+	for _, address := range s.addresses {
+		listener, err := listeners.Listen(address, s.permissions, s.tlsConfig)
+
+		if err != nil {
+			s.closeListeners()
+
+			return err
+		}
+
+		s.listeners = append(s.listeners, listener)
+
+		slog.Info("Subscriptions listening on " + address.String())
+	}
+
+	return nil
+}
+
+func (s *Server) Serve() error {
+	var accepting sync.WaitGroup
+
+	for _, listener := range s.listeners {
+		accepting.Add(1)
+
+		go func() {
+			defer accepting.Done()
+
+			s.accept(listener)
+		}()
+	}
+
+	accepting.Wait()
+
+	return nil
+}
+
+func (s *Server) accept(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+
+		if s.closing.Load() {
+			if conn != nil {
+				_ = conn.Close()
+			}
+
+			return
+		}
+
+		if err != nil {
+			slog.Error("Error listening subscriptions: " + err.Error())
+
+			continue
+		}
+
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) Close() error {
+	slog.Warn("Closing subscriptions server...")
+
+	s.closing.Store(true)
+
+	s.mutex.Lock()
+
+	for conn := range s.connections {
+		_ = conn.Close()
+	}
+
+	s.mutex.Unlock()
+
+	return errs.Err(errors.Join(s.closeListeners()...))
+}
+
+func (s *Server) closeListeners() []error {
+	var errList []error
+
+	for _, listener := range s.listeners {
+		if err := listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			errList = append(errList, err)
+		}
+	}
+
+	return errList
+}
+
+func (s *Server) serve(conn net.Conn) {
+	s.mutex.Lock()
+	s.connections[conn] = true
+	s.mutex.Unlock()
+
+	writer := &writer{
+		encoder: json.NewEncoder(conn),
+	}
+
+	var credential *auth.Credential
+	var subscriber *subscriptions.Subscriber
+	var pushing sync.WaitGroup
+
+	defer func() {
+		if subscriber != nil {
+			s.service.Unsubscribe(subscriber)
+		}
+
+		_ = conn.Close()
+
+		pushing.Wait()
+
+		s.mutex.Lock()
+		delete(s.connections, conn)
+		s.mutex.Unlock()
+	}()
+
+	scanner := bufio.NewScanner(conn)
+
+	scanner.Buffer(make([]byte, 0, 4096), maxRequestSize)
+
+	for scanner.Scan() {
+		var request Request
+
+		err := json.Unmarshal(scanner.Bytes(), &request)
+
+		if err != nil {
+			writer.write(&Message{Type: TypeError, Message: "invalid request: " + err.Error()})
+
+			continue
+		}
+
+		if request.Action == ActionAuthenticate {
+			if s.acl == nil {
+				writer.write(&Message{Type: TypeAuthenticated})
+
+				continue
+			}
+
+			credential = s.acl.Authenticate(request.Token)
+
+			if credential == nil {
+				writer.write(&Message{Type: TypeError, Message: auth.ErrUnauthenticated.Error()})
+
+				continue
+			}
+
+			writer.write(&Message{Type: TypeAuthenticated})
+
+			continue
+		}
+
+		if err = s.authorize(credential, request.Action); err != nil {
+			writer.write(&Message{Type: TypeError, Message: err.Error()})
+
+			continue
+		}
+
+		switch request.Action {
+		case ActionSubscribe:
+			if subscriber != nil {
+				writer.write(&Message{Type: TypeError, Message: "already subscribed"})
+
+				continue
+			}
+
+			if len(request.GroupUuids) == 0 {
+				writer.write(&Message{Type: TypeError, Message: "no groups to subscribe"})
+
+				continue
+			}
+
+			writer.write(&Message{Type: TypeSubscribed, GroupUuids: request.GroupUuids})
+
+			subscriber = s.service.Subscribe(request.GroupUuids)
+
+			pushing.Add(1)
+
+			go func() {
+				defer pushing.Done()
+
+				s.push(subscriber, writer)
+			}()
+		case ActionAck:
+			if subscriber == nil || !subscriber.IsSubscribed(request.GroupUuid) {
+				writer.write(&Message{Type: TypeError, Message: "group [" + request.GroupUuid + "] is not subscribed"})
+
+				continue
+			}
+
+			writer.write(&Message{
+				Type:         TypeAcked,
+				GroupUuid:    request.GroupUuid,
+				TaskUuid:     request.TaskUuid,
+				Acknowledged: s.service.AcknowledgeTask(request.GroupUuid, request.TaskUuid),
+			})
+		default:
+			writer.write(&Message{Type: TypeError, Message: "unknown action [" + request.Action + "]"})
+		}
+	}
+
+	if err := scanner.Err(); err != nil && !s.closing.Load() && !errors.Is(err, net.ErrClosed) {
+		slog.Warn("Subscription connection failed: " + err.Error())
+	}
+}
+
+func (s *Server) authorize(credential *auth.Credential, action string) error {
+	if s.acl == nil {
+		return nil
+	}
+
+	switch action {
+	case ActionSubscribe:
+		return s.acl.Authorize(credential, subscribeMethod, nil)
+	case ActionAck:
+		return s.acl.Authorize(credential, ackMethod, nil)
+	}
+
+	return nil
+}
+
+func (s *Server) push(subscriber *subscriptions.Subscriber, writer *writer) {
+	for task := range subscriber.Tasks() {
+		message := &Message{
+			Type:      TypeTask,
+			GroupUuid: task.GroupUuid,
+			TaskUuid:  task.TaskUuid,
+			IsError:   task.IsError,
+			IsTimeout: task.TimedOut,
+		}
+
+		response, err := payloads.Unpack(task.Response, task.ResponseEncoding)
+
+		if err != nil {
+			// the result was already collected or expired
+			continue
+		}
+
+		message.Response = response
+
+		if !writer.write(message) {
+			return
+		}
+	}
+}
+
+type writer struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	failed  bool
+}
+
+func (w *writer) write(message *Message) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.failed {
+		return false
+	}
+
+	if err := w.encoder.Encode(message); err != nil {
+		w.failed = true
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,82 @@
+package subscription
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"sparallel_server/internal/api/auth"
+	"sparallel_server/internal/api/listeners"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_RequiresAclToken(t *testing.T) {
+	acl := &auth.ACL{
+		Tokens: []*auth.Credential{
+			{Name: "acker", Token: "secret", Methods: []string{ackMethod}},
+		},
+	}
+
+	server := NewServer([]listeners.Address{{Network: "tcp", Address: "127.0.0.1:0"}}, 0, nil, acl, nil)
+
+	require.NoError(t, server.Listen())
+
+	go func() {
+		_ = server.Serve()
+	}()
+
+	defer func() {
+		_ = server.Close()
+	}()
+
+	conn, err := net.Dial("tcp", server.listeners[0].Addr().String())
+
+	require.NoError(t, err)
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	encoder := json.NewEncoder(conn)
+	scanner := bufio.NewScanner(conn)
+
+	send := func(request Request) Message {
+		require.NoError(t, encoder.Encode(request))
+		require.True(t, scanner.Scan())
+
+		var message Message
+
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &message))
+
+		return message
+	}
+
+	message := send(Request{Action: ActionSubscribe, GroupUuids: []string{"g"}})
+
+	assert.Equal(t, TypeError, message.Type)
+	assert.Contains(t, message.Message, auth.ErrUnauthenticated.Error())
+
+	message = send(Request{Action: ActionAck, GroupUuid: "g", TaskUuid: "t"})
+
+	assert.Equal(t, TypeError, message.Type)
+
+	message = send(Request{Action: ActionAuthenticate, Token: "unknown"})
+
+	assert.Equal(t, TypeError, message.Type)
+
+	message = send(Request{Action: ActionAuthenticate, Token: "secret"})
+
+	assert.Equal(t, TypeAuthenticated, message.Type)
+
+	message = send(Request{Action: ActionSubscribe, GroupUuids: []string{"g"}})
+
+	assert.Equal(t, TypeError, message.Type)
+	assert.Contains(t, message.Message, auth.ErrDenied.Error())
+
+	message = send(Request{Action: ActionAck, GroupUuid: "g", TaskUuid: "t"})
+
+	assert.Equal(t, TypeError, message.Type)
+	assert.Equal(t, "group [g] is not subscribed", message.Message)
+}
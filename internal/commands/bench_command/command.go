@@ -0,0 +1,217 @@
+package bench_command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/rpc"
+	"sparallel_server/internal/api/rpc/rpc_auth"
+	"sparallel_server/internal/config"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Command struct {
+}
+
+func (c *Command) Title() string {
+	return "Generate load on the running server and report throughput and latencies"
+}
+
+func (c *Command) Parameters() string {
+	return "[--target=workers|mongodb] [--concurrency=10] [--groups=10] [--group-size=10] [--payload-size=1024] " +
+		"[--task-ms=0] [--timeout=60] [--connection=] [--database=bench] [--collection=bench] [--json]"
+}
+
+func (c *Command) Handle(ctx context.Context, arguments []string) error {
+	options, err := parseOptions(arguments)
+
+	if err != nil {
+		return err
+	}
+
+	report, err := run(ctx, options)
+
+	if err != nil {
+		return err
+	}
+
+	if options.Json {
+		data, err := json.Marshal(report)
+
+		if err != nil {
+			return errs.Err(err)
+		}
+
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	printReport(report)
+
+	return nil
+}
+
+func (c *Command) Pause() error {
+	return nil
+}
+
+func (c *Command) UnPause() error {
+	return nil
+}
+
+func (c *Command) Close() error {
+	return nil
+}
+
+func parseOptions(arguments []string) (*Options, error) {
+	options := &Options{}
+
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+
+	flags.StringVar(&options.Target, "target", TargetWorkers, "workers or mongodb")
+	flags.IntVar(&options.Concurrency, "concurrency", 10, "number of concurrent clients")
+	flags.IntVar(&options.Groups, "groups", 10, "number of task groups")
+	flags.IntVar(&options.GroupSize, "group-size", 10, "tasks per group")
+	flags.IntVar(&options.PayloadSize, "payload-size", 1024, "payload size in bytes")
+	flags.IntVar(&options.TaskMs, "task-ms", 0, "task duration in milliseconds, the worker must understand the reference worker sleep payload")
+	flags.IntVar(&options.TimeoutSeconds, "timeout", 60, "timeout of a task in seconds")
+	flags.StringVar(&options.Connection, "connection", "", "mongodb proxy connection")
+	flags.StringVar(&options.Database, "database", "bench", "mongodb proxy database")
+	flags.StringVar(&options.Collection, "collection", "bench", "mongodb proxy collection")
+	flags.BoolVar(&options.Json, "json", false, "print the report as json")
+
+	if err := flags.Parse(arguments); err != nil {
+		return nil, errs.Err(err)
+	}
+
+	if options.Target != TargetWorkers && options.Target != TargetMongodb {
+		return nil, errs.Err(errors.New("unknown target [" + options.Target + "]"))
+	}
+
+	if options.Concurrency <= 0 || options.Groups <= 0 || options.GroupSize <= 0 {
+		return nil, errs.Err(errors.New("concurrency, groups and group size must be positive"))
+	}
+
+	return options, nil
+}
+
+func run(ctx context.Context, options *Options) (*Report, error) {
+	groups := make(chan int, options.Groups)
+
+	for i := range options.Groups {
+		groups <- i
+	}
+
+	close(groups)
+
+	runId := strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	results := make(chan []*result, options.Groups)
+
+	var waitGroup sync.WaitGroup
+	var clientsErr error
+	var clientsErrOnce sync.Once
+
+	clients := make([]*rpc.Client, 0, options.Concurrency)
+
+	for range options.Concurrency {
+		client, err := dial()
+
+		if err != nil {
+			for _, client := range clients {
+				_ = client.Close()
+			}
+
+			return nil, err
+		}
+
+		clients = append(clients, client)
+	}
+
+	startedAt := time.Now()
+
+	for _, client := range clients {
+		waitGroup.Add(1)
+
+		go func(client *rpc.Client) {
+			defer waitGroup.Done()
+
+			defer func() {
+				_ = client.Close()
+			}()
+
+			for index := range groups {
+				if ctx.Err() != nil {
+					return
+				}
+
+				groupResults, err := runGroup(client, options, runId+"-"+strconv.Itoa(index))
+
+				if err != nil {
+					clientsErrOnce.Do(func() {
+						clientsErr = err
+					})
+
+					return
+				}
+
+				results <- groupResults
+			}
+		}(client)
+	}
+
+	waitGroup.Wait()
+
+	close(results)
+
+	if clientsErr != nil {
+		return nil, clientsErr
+	}
+
+	var all []*result
+
+	for groupResults := range results {
+		all = append(all, groupResults...)
+	}
+
+	return makeReport(options, all, time.Since(startedAt)), nil
+}
+
+func runGroup(client *rpc.Client, options *Options, groupUuid string) ([]*result, error) {
+	if options.Target == TargetMongodb {
+		return runMongodbGroup(client, options, groupUuid)
+	}
+
+	return runWorkersGroup(client, options, groupUuid)
+}
+
+func dial() (*rpc.Client, error) {
+	return rpc_auth.Dial(config.GetConfig().GetRpcPort())
+}
+
+func makePayload(size int) string {
+	return strings.Repeat("x", size)
+}
+
+func printReport(report *Report) {
+	fmt.Println("Target:      " + report.Target)
+	fmt.Println("Concurrency: " + strconv.Itoa(report.Concurrency))
+	fmt.Printf("Operations:  %d total, %d succeeded, %d failed, %d timed out\n",
+		report.Total, report.Succeeded, report.Failed, report.TimedOut)
+	fmt.Printf("Error rate:  %.2f%%\n", report.ErrorRate*100)
+	fmt.Printf("Duration:    %.0f ms\n", report.DurationMs)
+	fmt.Printf("Throughput:  %.1f ops/s\n", report.Throughput)
+	fmt.Printf("Latency:     avg %.1f ms, p50 %.1f ms, p90 %.1f ms, p99 %.1f ms, max %.1f ms\n",
+		report.Latency.AvgMs, report.Latency.P50Ms, report.Latency.P90Ms, report.Latency.P99Ms, report.Latency.MaxMs)
+
+	for message, count := range report.Errors {
+		fmt.Println("Error [" + strconv.Itoa(count) + "]: " + message)
+	}
+}
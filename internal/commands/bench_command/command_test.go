@@ -0,0 +1,66 @@
+package bench_command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sparallel_server/internal/e2e"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	harness, err := e2e.Start(nil)
+
+	if err != nil {
+		fmt.Println(err.Error())
+
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	_ = harness.Close()
+
+	os.Exit(code)
+}
+
+func TestRunWorkers(t *testing.T) {
+	options, err := parseOptions([]string{"--concurrency=2", "--groups=3", "--group-size=5", "--payload-size=16", "--task-ms=10"})
+
+	require.NoError(t, err)
+
+	report, err := run(context.Background(), options)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, 15, report.Total)
+	assert.Equal(t, 15, report.Succeeded)
+	assert.Equal(t, float64(0), report.ErrorRate)
+	assert.Greater(t, report.Throughput, float64(0))
+	assert.GreaterOrEqual(t, report.Latency.P99Ms, report.Latency.P50Ms)
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.Equal(t, float64(5), percentile(sorted, 50))
+	assert.Equal(t, float64(9), percentile(sorted, 90))
+	assert.Equal(t, float64(10), percentile(sorted, 99))
+	assert.Equal(t, float64(1), percentile([]float64{1}, 50))
+}
+
+func TestParseOptions(t *testing.T) {
+	_, err := parseOptions([]string{"--target=unknown"})
+
+	assert.Error(t, err)
+
+	_, err = parseOptions([]string{"--concurrency=0"})
+
+	assert.Error(t, err)
+}
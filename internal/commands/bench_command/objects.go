@@ -0,0 +1,49 @@
+package bench_command
+
+const (
+	TargetWorkers = "workers"
+	TargetMongodb = "mongodb"
+)
+
+type Options struct {
+	Target         string
+	Concurrency    int
+	Groups         int
+	GroupSize      int
+	PayloadSize    int
+	TaskMs         int
+	TimeoutSeconds int
+	Connection     string
+	Database       string
+	Collection     string
+	Json           bool
+}
+
+type Report struct {
+	Target      string
+	Concurrency int
+	Total       int
+	Succeeded   int
+	Failed      int
+	TimedOut    int
+	ErrorRate   float64
+	DurationMs  float64
+	Throughput  float64
+	Latency     LatencyReport
+	Errors      map[string]int `json:",omitempty"`
+}
+
+type LatencyReport struct {
+	AvgMs float64
+	P50Ms float64
+	P90Ms float64
+	P99Ms float64
+	MaxMs float64
+}
+
+type result struct {
+	latencyMs float64
+	isError   bool
+	isTimeout bool
+	message   string
+}
@@ -0,0 +1,69 @@
+package bench_command
+
+import (
+	"slices"
+	"time"
+)
+
+const maxErrorMessages = 10
+
+func makeReport(options *Options, results []*result, duration time.Duration) *Report {
+	report := &Report{
+		Target:      options.Target,
+		Concurrency: options.Concurrency,
+		Total:       len(results),
+		DurationMs:  float64(duration.Microseconds()) / 1000,
+		Errors:      make(map[string]int),
+	}
+
+	latencies := make([]float64, 0, len(results))
+
+	var sum float64
+
+	for _, item := range results {
+		latencies = append(latencies, item.latencyMs)
+
+		sum += item.latencyMs
+
+		if item.isTimeout {
+			report.TimedOut++
+		}
+
+		if !item.isError {
+			report.Succeeded++
+
+			continue
+		}
+
+		report.Failed++
+
+		if _, exists := report.Errors[item.message]; exists || len(report.Errors) < maxErrorMessages {
+			report.Errors[item.message]++
+		}
+	}
+
+	if report.Total == 0 {
+		return report
+	}
+
+	slices.Sort(latencies)
+
+	report.ErrorRate = float64(report.Failed) / float64(report.Total)
+	report.Throughput = float64(report.Total) / duration.Seconds()
+	report.Latency = LatencyReport{
+		AvgMs: sum / float64(report.Total),
+		P50Ms: percentile(latencies, 50),
+		P90Ms: percentile(latencies, 90),
+		P99Ms: percentile(latencies, 99),
+		MaxMs: latencies[len(latencies)-1],
+	}
+
+	return report
+}
+
+// percentile takes the nearest rank of the sorted values
+func percentile(sorted []float64, percent int) float64 {
+	index := (len(sorted)*percent+99)/100 - 1
+
+	return sorted[max(index, 0)]
+}
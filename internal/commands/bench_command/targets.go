@@ -0,0 +1,172 @@
+package bench_command
+
+import (
+	"net/rpc"
+	"sparallel_server/internal/api/rpc/rpc_proxy_mongodb"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+	"time"
+)
+
+const pollInterval = 5 * time.Millisecond
+
+func runWorkersGroup(client *rpc.Client, options *Options, groupUuid string) ([]*result, error) {
+	payload := makePayload(options.PayloadSize)
+
+	if options.TaskMs > 0 {
+		payload = "sleep:" + strconv.Itoa(options.TaskMs) + ":" + payload
+	}
+
+	addedAt := make(map[string]time.Time, options.GroupSize)
+
+	for i := range options.GroupSize {
+		args := rpc_workers.AddTaskArgs{
+			GroupUuid:   groupUuid,
+			TaskUuid:    groupUuid + "-" + strconv.Itoa(i),
+			UnixTimeout: int(time.Now().Unix()) + options.TimeoutSeconds,
+			Payload:     payload,
+		}
+
+		var reply rpc_workers.AddTaskResult
+
+		if err := client.Call("WorkersServer.AddTask", args, &reply); err != nil {
+			return nil, errs.Err(err)
+		}
+
+		addedAt[args.TaskUuid] = time.Now()
+	}
+
+	results := make([]*result, 0, options.GroupSize)
+
+	// the server keeps results a few seconds after the deadline
+	deadline := time.Now().Add(time.Duration(options.TimeoutSeconds+10) * time.Second)
+
+	for len(addedAt) > 0 {
+		var reply rpc_workers.DetectFinishedTaskResult
+
+		err := client.Call(
+			"WorkersServer.DetectAnyFinishedTask",
+			rpc_workers.DetectFinishedTaskArgs{GroupUuid: groupUuid},
+			&reply,
+		)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		if !reply.IsFinished {
+			if time.Now().After(deadline) {
+				break
+			}
+
+			time.Sleep(pollInterval)
+
+			continue
+		}
+
+		startedAt, exists := addedAt[reply.TaskUuid]
+
+		if !exists {
+			continue
+		}
+
+		delete(addedAt, reply.TaskUuid)
+
+		taskResult := &result{
+			latencyMs: msSince(startedAt),
+			isError:   reply.IsError,
+			isTimeout: reply.IsTimeout,
+		}
+
+		if reply.IsError {
+			taskResult.message = reply.Response
+		}
+
+		results = append(results, taskResult)
+	}
+
+	for range addedAt {
+		results = append(results, &result{
+			latencyMs: float64(options.TimeoutSeconds * 1000),
+			isError:   true,
+			isTimeout: true,
+			message:   "lost",
+		})
+	}
+
+	return results, nil
+}
+
+func runMongodbGroup(client *rpc.Client, options *Options, groupUuid string) ([]*result, error) {
+	document := `{"group":"` + groupUuid + `","payload":"` + makePayload(options.PayloadSize) + `"}`
+
+	results := make([]*result, 0, options.GroupSize)
+
+	for range options.GroupSize {
+		startedAt := time.Now()
+
+		var insertReply rpc_proxy_mongodb.InsertOneReply
+
+		err := client.Call(
+			"ProxyMongodbServer.InsertOne",
+			rpc_proxy_mongodb.InsertOneArgs{
+				Connection: options.Connection,
+				Database:   options.Database,
+				Collection: options.Collection,
+				Document:   document,
+			},
+			&insertReply,
+		)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		if insertReply.Error != "" {
+			results = append(results, &result{latencyMs: msSince(startedAt), isError: true, message: insertReply.Error})
+
+			continue
+		}
+
+		deadline := startedAt.Add(time.Duration(options.TimeoutSeconds) * time.Second)
+
+		for {
+			var resultReply rpc_proxy_mongodb.ResultReply
+
+			err = client.Call(
+				"ProxyMongodbServer.InsertOneResult",
+				rpc_proxy_mongodb.ResultArgs{OperationUuid: insertReply.OperationUuid},
+				&resultReply,
+			)
+
+			if err != nil {
+				return nil, errs.Err(err)
+			}
+
+			if resultReply.IsFinished {
+				results = append(results, &result{
+					latencyMs: msSince(startedAt),
+					isError:   resultReply.Error != "",
+					message:   resultReply.Error,
+				})
+
+				break
+			}
+
+			if time.Now().After(deadline) {
+				results = append(results, &result{latencyMs: msSince(startedAt), isError: true, isTimeout: true, message: "timeout"})
+
+				break
+			}
+
+			time.Sleep(pollInterval)
+		}
+	}
+
+	return results, nil
+}
+
+func msSince(startedAt time.Time) float64 {
+	return float64(time.Since(startedAt).Microseconds()) / 1000
+}
@@ -1,19 +1,25 @@
 package commands
 
 import (
+	"sparallel_server/internal/commands/bench_command"
 	"sparallel_server/internal/commands/hello_command"
 	"sparallel_server/internal/commands/serve_rpc_command"
+	"sparallel_server/internal/commands/task_events_command"
 	foundationCommands "sparallel_server/pkg/foundation/commands"
 )
 
 const (
-	HelloCommandName    = "hello"
-	ServeRpcCommandName = "start"
+	HelloCommandName      = "hello"
+	ServeRpcCommandName   = "start"
+	TaskEventsCommandName = "task-events"
+	BenchCommandName      = "bench"
 )
 
 var commands = map[string]foundationCommands.CommandInterface{
-	HelloCommandName:    &hello_command.Command{},
-	ServeRpcCommandName: &serve_rpc_command.Command{},
+	HelloCommandName:      &hello_command.Command{},
+	ServeRpcCommandName:   &serve_rpc_command.Command{},
+	TaskEventsCommandName: &task_events_command.Command{},
+	BenchCommandName:      &bench_command.Command{},
 }
 
 func GetCommands() map[string]foundationCommands.CommandInterface {
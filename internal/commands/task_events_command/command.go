@@ -0,0 +1,86 @@
+package task_events_command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/rpc"
+	"sparallel_server/internal/api/rpc/rpc_auth"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/config"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+)
+
+type Command struct {
+}
+
+func (c *Command) Title() string {
+	return "Print lifecycle events of a task from the running server"
+}
+
+func (c *Command) Parameters() string {
+	return "{taskUuid}"
+}
+
+func (c *Command) Handle(_ context.Context, arguments []string) error {
+	if len(arguments) == 0 || arguments[0] == "" {
+		return errs.Err(errors.New("task uuid is required"))
+	}
+
+	client, err := rpc_auth.Dial(config.GetConfig().GetRpcPort())
+
+	if err != nil {
+		return err
+	}
+
+	defer func(client *rpc.Client) {
+		_ = client.Close()
+	}(client)
+
+	var result rpc_workers.GetTaskEventsResult
+
+	err = client.Call(
+		"WorkersServer.GetTaskEvents",
+		rpc_workers.GetTaskEventsArgs{TaskUuid: arguments[0]},
+		&result,
+	)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	if len(result.Events) == 0 {
+		fmt.Println("No events for task [" + arguments[0] + "]")
+
+		return nil
+	}
+
+	for _, event := range result.Events {
+		line := event.Time + " " + event.Type + " group [" + event.GroupUuid + "]"
+
+		if event.Pid > 0 {
+			line += " pid [" + strconv.Itoa(event.Pid) + "]"
+		}
+
+		if event.Message != "" {
+			line += " " + event.Message
+		}
+
+		fmt.Println(line)
+	}
+
+	return nil
+}
+
+func (c *Command) Pause() error {
+	return nil
+}
+
+func (c *Command) UnPause() error {
+	return nil
+}
+
+func (c *Command) Close() error {
+	return nil
+}
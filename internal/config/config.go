@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -32,6 +33,92 @@ func (c *Config) GetRpcPort() string {
 	return os.Getenv("RPC_PORT")
 }
 
+func (c *Config) GetRpcListen() []string {
+	var addresses []string
+
+	for _, address := range strings.Split(os.Getenv("RPC_LISTEN"), ",") {
+		address = strings.TrimSpace(address)
+
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+func (c *Config) GetRpcSocketPermissions() os.FileMode {
+	value, err := strconv.ParseUint(os.Getenv("RPC_SOCKET_PERMISSIONS"), 8, 32)
+
+	if err != nil {
+		return 0660
+	}
+
+	return os.FileMode(value)
+}
+
+func (c *Config) GetRpcTlsCertFile() string {
+	return os.Getenv("RPC_TLS_CERT_FILE")
+}
+
+func (c *Config) GetRpcTlsKeyFile() string {
+	return os.Getenv("RPC_TLS_KEY_FILE")
+}
+
+func (c *Config) GetRpcTlsClientCaFile() string {
+	return os.Getenv("RPC_TLS_CLIENT_CA_FILE")
+}
+
+func (c *Config) GetRpcAclFile() string {
+	return os.Getenv("RPC_ACL_FILE")
+}
+
+func (c *Config) GetRpcClientToken() string {
+	return os.Getenv("RPC_CLIENT_TOKEN")
+}
+
+func (c *Config) IsRpcClientTls() bool {
+	return c.GetRpcTlsCertFile() != "" || c.GetRpcClientTlsCaFile() != ""
+}
+
+func (c *Config) GetRpcClientTlsCaFile() string {
+	return os.Getenv("RPC_CLIENT_TLS_CA_FILE")
+}
+
+func (c *Config) GetRpcClientTlsCertFile() string {
+	return os.Getenv("RPC_CLIENT_TLS_CERT_FILE")
+}
+
+func (c *Config) GetRpcClientTlsKeyFile() string {
+	return os.Getenv("RPC_CLIENT_TLS_KEY_FILE")
+}
+
+func (c *Config) GetRpcClientTlsServerName() string {
+	return os.Getenv("RPC_CLIENT_TLS_SERVER_NAME")
+}
+
+func (c *Config) GetRpcShutdownTimeoutSeconds() int {
+	value, err := strconv.Atoi(os.Getenv("RPC_SHUTDOWN_TIMEOUT_SECONDS"))
+
+	if err != nil {
+		return 10
+	}
+
+	return value
+}
+
+func (c *Config) GetSubscriptionPort() string {
+	return os.Getenv("SUBSCRIPTION_PORT")
+}
+
+func (c *Config) GetHttpPort() string {
+	return os.Getenv("HTTP_PORT")
+}
+
+func (c *Config) GetGrpcPort() string {
+	return os.Getenv("GRPC_PORT")
+}
+
 func (c *Config) GetCommand() string {
 	return os.Getenv("WORKER_COMMAND")
 }
@@ -68,3 +155,174 @@ func (c *Config) GetWorkersNumberPercentScaleDown() int {
 	value, _ := strconv.Atoi(os.Getenv("WORKERS_NUMBER_PERCENT_SCALE_DOWN"))
 	return value
 }
+
+func (c *Config) GetWorkersDispatchMode() string {
+	return os.Getenv("WORKERS_DISPATCH_MODE")
+}
+
+func (c *Config) GetWorkersEventsLimit() int {
+	value, _ := strconv.Atoi(os.Getenv("WORKERS_EVENTS_LIMIT"))
+	return value
+}
+
+func (c *Config) GetWorkersEventsFilePath() string {
+	return os.Getenv("WORKERS_EVENTS_FILE_PATH")
+}
+
+func (c *Config) GetPayloadCompressThreshold() int {
+	value, _ := strconv.Atoi(os.Getenv("PAYLOAD_COMPRESS_THRESHOLD"))
+	return value
+}
+
+func (c *Config) GetPayloadSpillThreshold() int {
+	value, _ := strconv.Atoi(os.Getenv("PAYLOAD_SPILL_THRESHOLD"))
+	return value
+}
+
+func (c *Config) GetPayloadSpillDir() string {
+	return os.Getenv("PAYLOAD_SPILL_DIR")
+}
+
+func (c *Config) GetWorkerTags() []string {
+	var tags []string
+
+	for _, tag := range strings.Split(os.Getenv("WORKER_TAGS"), ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+func (c *Config) IsWorkerHandshake() bool {
+	return os.Getenv("WORKER_HANDSHAKE") == "true"
+}
+
+func (c *Config) GetWorkerKillGraceSeconds() int {
+	value, err := strconv.Atoi(os.Getenv("WORKER_KILL_GRACE_SECONDS"))
+
+	if err != nil {
+		return 3
+	}
+
+	return value
+}
+
+func (c *Config) GetWorkersRateLimit() float64 {
+	value, _ := strconv.ParseFloat(os.Getenv("WORKERS_RATE_LIMIT"), 64)
+	return value
+}
+
+func (c *Config) GetWorkersRateBurst() int {
+	value, _ := strconv.Atoi(os.Getenv("WORKERS_RATE_BURST"))
+	return value
+}
+
+func (c *Config) GetWorkersWaitingTtl() int {
+	value, err := strconv.Atoi(os.Getenv("WORKERS_WAITING_TTL"))
+
+	if err != nil {
+		return 5
+	}
+
+	return value
+}
+
+func (c *Config) GetWorkersResultTtl() int {
+	value, _ := strconv.Atoi(os.Getenv("WORKERS_RESULT_TTL"))
+	return value
+}
+
+func (c *Config) GetWorkersFinishedMaxBytes() int {
+	value, _ := strconv.Atoi(os.Getenv("WORKERS_FINISHED_MAX_BYTES"))
+	return value
+}
+
+func (c *Config) GetCallbackSecret() string {
+	return os.Getenv("CALLBACK_SECRET")
+}
+
+func (c *Config) GetCallbackAllowedHosts() []string {
+	var hosts []string
+
+	for _, host := range strings.Split(os.Getenv("CALLBACK_ALLOWED_HOSTS"), ",") {
+		host = strings.TrimSpace(host)
+
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
+
+func (c *Config) GetCallbackSocketDir() string {
+	return os.Getenv("CALLBACK_SOCKET_DIR")
+}
+
+func (c *Config) GetCallbackMaxAttempts() int {
+	value, err := strconv.Atoi(os.Getenv("CALLBACK_MAX_ATTEMPTS"))
+
+	if err != nil {
+		return 5
+	}
+
+	return value
+}
+
+func (c *Config) GetCallbackBackoffMs() int {
+	value, err := strconv.Atoi(os.Getenv("CALLBACK_BACKOFF_MS"))
+
+	if err != nil {
+		return 500
+	}
+
+	return value
+}
+
+func (c *Config) GetCallbackTimeoutSeconds() int {
+	value, err := strconv.Atoi(os.Getenv("CALLBACK_TIMEOUT_SECONDS"))
+
+	if err != nil {
+		return 5
+	}
+
+	return value
+}
+
+func (c *Config) GetCallbackWorkersNumber() int {
+	value, err := strconv.Atoi(os.Getenv("CALLBACK_WORKERS_NUMBER"))
+
+	if err != nil {
+		return 4
+	}
+
+	return value
+}
+
+func (c *Config) GetCallbackQueueSize() int {
+	value, err := strconv.Atoi(os.Getenv("CALLBACK_QUEUE_SIZE"))
+
+	if err != nil {
+		return 1000
+	}
+
+	return value
+}
+
+func (c *Config) IsChaosMode() bool {
+	return os.Getenv("CHAOS_MODE") == "true"
+}
+
+func (c *Config) GetChaosDispatchDelayMs() int {
+	value, err := strconv.Atoi(os.Getenv("CHAOS_DISPATCH_DELAY_MS"))
+
+	if err != nil {
+		return 1000
+	}
+
+	return value
+}
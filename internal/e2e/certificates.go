@@ -0,0 +1,126 @@
+package e2e
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sparallel_server/pkg/foundation/errs"
+	"time"
+)
+
+// Certificates are the PEM files of a test CA, a server certificate of 127.0.0.1 and localhost
+// and a client certificate, all signed by the CA
+type Certificates struct {
+	CaFile         string
+	ServerCertFile string
+	ServerKeyFile  string
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+func WriteCertificates(dir string) (*Certificates, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sparallel e2e ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	ca, err := x509.ParseCertificate(caDer)
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	certificates := &Certificates{
+		CaFile:         filepath.Join(dir, "ca.pem"),
+		ServerCertFile: filepath.Join(dir, "server.pem"),
+		ServerKeyFile:  filepath.Join(dir, "server.key"),
+		ClientCertFile: filepath.Join(dir, "client.pem"),
+		ClientKeyFile:  filepath.Join(dir, "client.key"),
+	}
+
+	if err = writePem(certificates.CaFile, "CERTIFICATE", caDer); err != nil {
+		return nil, err
+	}
+
+	server := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	if err = writePair(ca, caKey, server, certificates.ServerCertFile, certificates.ServerKeyFile); err != nil {
+		return nil, err
+	}
+
+	client := &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	if err = writePair(ca, caKey, client, certificates.ClientCertFile, certificates.ClientKeyFile); err != nil {
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
+func writePair(ca *x509.Certificate, caKey *ecdsa.PrivateKey, template *x509.Certificate, certPath string, keyPath string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(24 * time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	if err = writePem(certPath, "CERTIFICATE", der); err != nil {
+		return err
+	}
+
+	return writePem(keyPath, "EC PRIVATE KEY", keyDer)
+}
+
+func writePem(path string, blockType string, der []byte) error {
+	return errs.Err(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
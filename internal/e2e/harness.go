@@ -0,0 +1,218 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	appRpc "sparallel_server/internal/api/rpc"
+	"sparallel_server/internal/api/rpc/rpc_auth"
+	"sparallel_server/internal/providers"
+	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/pkg/foundation/app"
+	appConfig "sparallel_server/pkg/foundation/config"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var started atomic.Bool
+
+// Harness runs the rpc server in the current process with the reference worker.
+// The servers are singletons, so a harness can be started once per process.
+type Harness struct {
+	Port string
+
+	dir    string
+	env    map[string]string
+	mutex  sync.Mutex
+	server *appRpc.Server
+	cancel context.CancelFunc
+}
+
+func Start(env map[string]string) (*Harness, error) {
+	if !started.CompareAndSwap(false, true) {
+		return nil, errs.Err(errors.New("harness is already started in this process"))
+	}
+
+	dir, err := os.MkdirTemp("", "sparallel-e2e-")
+
+	if err != nil {
+		return nil, errs.Err(err)
+	}
+
+	workerPath := filepath.Join(dir, "reference_worker")
+
+	output, err := exec.Command("go", "build", "-o", workerPath, "sparallel_server/cmd/reference_worker").CombinedOutput()
+
+	if err != nil {
+		return nil, errs.Err(errors.New("can't build reference worker: " + string(output)))
+	}
+
+	port, err := FreePort()
+
+	if err != nil {
+		return nil, err
+	}
+
+	harness := &Harness{
+		Port: port,
+		dir:  dir,
+		env: map[string]string{
+			"RPC_PORT":                          port,
+			"SERVE_WORKERS":                     "true",
+			"WORKER_COMMAND":                    workerPath,
+			"MIN_WORKERS_NUMBER":                "2",
+			"MAX_WORKERS_NUMBER":                "4",
+			"WORKERS_NUMBER_SCALE_UP":           "1",
+			"WORKERS_NUMBER_PERCENT_SCALE_UP":   "80",
+			"WORKERS_NUMBER_PERCENT_SCALE_DOWN": "20",
+			"WORKER_KILL_GRACE_SECONDS":         "1",
+		},
+	}
+
+	for key, value := range env {
+		harness.env[key] = value
+	}
+
+	if err = harness.writeEnv(); err != nil {
+		return nil, err
+	}
+
+	appConfig.Init(harness.envPath())
+
+	if err = providers.NewCoreProvider().Register(app.GetRegistry()); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	harness.cancel = cancel
+	harness.server = appRpc.NewServer(port)
+
+	go func() {
+		_ = harness.server.Run(ctx)
+	}()
+
+	if err = harness.waitListening(5 * time.Second); err != nil {
+		_ = harness.Close()
+
+		return nil, err
+	}
+
+	return harness, nil
+}
+
+func (h *Harness) Client() (*rpc.Client, error) {
+	return rpc_auth.Dial(h.Port)
+}
+
+func (h *Harness) SetEnv(key string, value string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.env[key] = value
+
+	return h.writeEnv()
+}
+
+func (h *Harness) Service() *workers_server.Service {
+	return workers_server.GetService()
+}
+
+func (h *Harness) WaitFor(timeout time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		if condition() {
+			return true
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	return condition()
+}
+
+func (h *Harness) Close() error {
+	var err error
+
+	if h.server != nil {
+		err = h.server.Close()
+	}
+
+	h.cancel()
+
+	_ = os.RemoveAll(h.dir)
+
+	return err
+}
+
+func (h *Harness) envPath() string {
+	return filepath.Join(h.dir, ".env")
+}
+
+func (h *Harness) writeEnv() error {
+	keys := make([]string, 0, len(h.env))
+
+	for key := range h.env {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	var content string
+
+	for _, key := range keys {
+		content += key + "=" + strconv.Quote(h.env[key]) + "\n"
+	}
+
+	// the config is reloaded concurrently, so the file is replaced atomically
+	tmpPath := h.envPath() + ".tmp"
+
+	if err := os.WriteFile(tmpPath, []byte(content), 0600); err != nil {
+		return errs.Err(err)
+	}
+
+	return errs.Err(os.Rename(tmpPath, h.envPath()))
+}
+
+func (h *Harness) waitListening(timeout time.Duration) error {
+	listening := h.WaitFor(timeout, func() bool {
+		client, err := h.Client()
+
+		if err != nil {
+			return false
+		}
+
+		_ = client.Close()
+
+		return true
+	})
+
+	if !listening {
+		return errs.Err(errors.New("rpc server is not listening on port [" + h.Port + "]"))
+	}
+
+	return nil
+}
+
+func FreePort() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return "", errs.Err(err)
+	}
+
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port), nil
+}
@@ -0,0 +1,203 @@
+package tls_e2e_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/rpc"
+	"os"
+	"sparallel_server/internal/api/grpc_api/pb"
+	"sparallel_server/internal/api/rpc/rpc_auth"
+	"sparallel_server/internal/api/rpc/rpc_ping_pong"
+	"sparallel_server/internal/api/subscription"
+	"sparallel_server/internal/e2e"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var harness *e2e.Harness
+var ports = map[string]string{}
+
+func TestMain(m *testing.M) {
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	code, err := run(m)
+
+	if err != nil {
+		fmt.Println(err.Error())
+
+		os.Exit(1)
+	}
+
+	os.Exit(code)
+}
+
+func run(m *testing.M) (int, error) {
+	dir, err := os.MkdirTemp("", "sparallel-tls-")
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	certificates, err := e2e.WriteCertificates(dir)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range []string{"HTTP_PORT", "GRPC_PORT", "SUBSCRIPTION_PORT"} {
+		if ports[name], err = e2e.FreePort(); err != nil {
+			return 0, err
+		}
+	}
+
+	harness, err = e2e.Start(map[string]string{
+		"RPC_TLS_CERT_FILE":        certificates.ServerCertFile,
+		"RPC_TLS_KEY_FILE":         certificates.ServerKeyFile,
+		"RPC_TLS_CLIENT_CA_FILE":   certificates.CaFile,
+		"RPC_CLIENT_TLS_CA_FILE":   certificates.CaFile,
+		"RPC_CLIENT_TLS_CERT_FILE": certificates.ClientCertFile,
+		"RPC_CLIENT_TLS_KEY_FILE":  certificates.ClientKeyFile,
+		"HTTP_PORT":                ports["HTTP_PORT"],
+		"GRPC_PORT":                ports["GRPC_PORT"],
+		"SUBSCRIPTION_PORT":        ports["SUBSCRIPTION_PORT"],
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		_ = harness.Close()
+	}()
+
+	return m.Run(), nil
+}
+
+func TestTls_Rpc(t *testing.T) {
+	client, err := harness.Client()
+
+	require.NoError(t, err)
+
+	defer func() {
+		_ = client.Close()
+	}()
+
+	var result rpc_ping_pong.PingResult
+
+	require.NoError(t, client.Call("PingPongServer.Ping", rpc_ping_pong.PingArgs{Message: "tls"}, &result))
+
+	assert.Equal(t, "tls", result.Message)
+
+	conn, err := net.DialTimeout("tcp", "127.0.0.1:"+harness.Port, time.Second)
+
+	require.NoError(t, err)
+
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	plain := rpc.NewClientWithCodec(goridgeRpc.NewClientCodec(conn))
+
+	defer func() {
+		_ = plain.Close()
+	}()
+
+	assert.Error(t, plain.Call("PingPongServer.Ping", rpc_ping_pong.PingArgs{Message: "plain"}, &result))
+}
+
+func TestTls_HttpGateway(t *testing.T) {
+	client := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: clientTlsConfig(t)},
+	}
+
+	url := "://127.0.0.1:" + ports["HTTP_PORT"] + "/rpc/PingPongServer.Ping"
+
+	response, err := client.Post("https"+url, "application/json", strings.NewReader(`{"Message":"tls"}`))
+
+	require.NoError(t, err)
+
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	response, err = client.Post("http"+url, "application/json", strings.NewReader(`{"Message":"plain"}`))
+
+	if err == nil {
+		_ = response.Body.Close()
+
+		assert.NotEqual(t, http.StatusOK, response.StatusCode)
+	}
+}
+
+func TestTls_Grpc(t *testing.T) {
+	connection, err := grpc.NewClient(
+		"127.0.0.1:"+ports["GRPC_PORT"],
+		grpc.WithTransportCredentials(credentials.NewTLS(clientTlsConfig(t))),
+	)
+
+	require.NoError(t, err)
+
+	defer func() {
+		_ = connection.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := pb.NewManagerServiceClient(connection).Stats(ctx, &pb.MessageRequest{})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.GetJson())
+}
+
+func TestTls_Subscription(t *testing.T) {
+	conn, err := tls.Dial("tcp", "127.0.0.1:"+ports["SUBSCRIPTION_PORT"], clientTlsConfig(t))
+
+	require.NoError(t, err)
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	require.NoError(t, json.NewEncoder(conn).Encode(subscription.Request{
+		Action:     subscription.ActionSubscribe,
+		GroupUuids: []string{"tls-group"},
+	}))
+
+	scanner := bufio.NewScanner(conn)
+
+	require.True(t, scanner.Scan())
+
+	var message subscription.Message
+
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &message))
+
+	assert.Equal(t, subscription.TypeSubscribed, message.Type)
+}
+
+func clientTlsConfig(t *testing.T) *tls.Config {
+	config, err := rpc_auth.ClientTlsConfig()
+
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	return config
+}
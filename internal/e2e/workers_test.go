@@ -0,0 +1,262 @@
+package e2e
+
+import (
+	"fmt"
+	"log/slog"
+	"net/rpc"
+	"os"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/services/workers_server/events"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var harness *Harness
+
+func TestMain(m *testing.M) {
+	var err error
+
+	slog.SetLogLoggerLevel(slog.LevelError)
+
+	harness, err = Start(nil)
+
+	if err != nil {
+		fmt.Println(err.Error())
+
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	_ = harness.Close()
+
+	os.Exit(code)
+}
+
+func TestAddAndDispatch(t *testing.T) {
+	client := newClient(t)
+
+	groupUuid := uuid.New().String()
+
+	addTask(t, client, groupUuid, "echo:hello", 10)
+	addTask(t, client, groupUuid, "plain", 10)
+
+	responses := map[string]bool{}
+
+	for range 2 {
+		result := waitFinished(t, client, groupUuid, 5*time.Second)
+
+		assert.False(t, result.IsError)
+
+		responses[result.Response] = true
+	}
+
+	assert.Equal(t, map[string]bool{"hello": true, "plain": true}, responses)
+}
+
+func TestBrokenWorkers(t *testing.T) {
+	client := newClient(t)
+
+	for _, payload := range []string{"crash", "garbage:this output is not a length header"} {
+		groupUuid := uuid.New().String()
+
+		addTask(t, client, groupUuid, payload, 10)
+
+		result := waitFinished(t, client, groupUuid, 5*time.Second)
+
+		assert.True(t, result.IsError, payload)
+		assert.False(t, result.IsTimeout, payload)
+	}
+
+	groupUuid := uuid.New().String()
+
+	addTask(t, client, groupUuid, "echo:alive", 10)
+
+	result := waitFinished(t, client, groupUuid, 5*time.Second)
+
+	assert.Equal(t, "alive", result.Response)
+}
+
+func TestTimeout(t *testing.T) {
+	client := newClient(t)
+
+	groupUuid := uuid.New().String()
+
+	// the deadline is 5 seconds after the timeout
+	taskUuid := addTask(t, client, groupUuid, "sleep:20000:late", 0)
+
+	result := waitFinished(t, client, groupUuid, 10*time.Second)
+
+	assert.True(t, result.IsError)
+	assert.True(t, result.IsTimeout)
+	assert.True(t, hasEvent(t, client, taskUuid, events.TypeTimeout))
+}
+
+func TestCancelGroup(t *testing.T) {
+	client := newClient(t)
+
+	groupUuid := uuid.New().String()
+
+	var taskUuids []string
+
+	for i := range 10 {
+		taskUuids = append(taskUuids, addTask(t, client, groupUuid, "sleep:1000:"+strconv.Itoa(i), 30))
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	var cancelResult rpc_workers.CancelGroupResult
+
+	require.NoError(t, client.Call("WorkersServer.CancelGroup", rpc_workers.CancelGroupArgs{GroupUuid: groupUuid}, &cancelResult))
+
+	cancelled := harness.WaitFor(3*time.Second, func() bool {
+		return hasEvent(t, client, taskUuids[len(taskUuids)-1], events.TypeCancelled)
+	})
+
+	assert.True(t, cancelled)
+
+	time.Sleep(1500 * time.Millisecond)
+
+	for {
+		result := detectFinished(t, client, groupUuid)
+
+		if !result.IsFinished {
+			break
+		}
+
+		// only the interrupted running tasks may finish
+		assert.True(t, result.IsError)
+	}
+}
+
+func TestReload(t *testing.T) {
+	client := newClient(t)
+
+	addedCount := harness.Service().Stats().Workers.AddedCount
+
+	var reloadResult rpc_workers.ReloadResult
+
+	require.NoError(t, client.Call("WorkersServer.Reload", rpc_workers.ReloadArgs{Message: "e2e"}, &reloadResult))
+
+	reloaded := harness.WaitFor(5*time.Second, func() bool {
+		return harness.Service().Stats().Workers.AddedCount > addedCount
+	})
+
+	assert.True(t, reloaded)
+
+	groupUuid := uuid.New().String()
+
+	addTask(t, client, groupUuid, "echo:reloaded", 10)
+
+	assert.Equal(t, "reloaded", waitFinished(t, client, groupUuid, 5*time.Second).Response)
+}
+
+func TestScale(t *testing.T) {
+	require.NoError(t, harness.SetEnv("MIN_WORKERS_NUMBER", "4"))
+
+	scaledUp := harness.WaitFor(5*time.Second, func() bool {
+		return harness.Service().Stats().Workers.Count == 4
+	})
+
+	assert.True(t, scaledUp)
+
+	require.NoError(t, harness.SetEnv("MIN_WORKERS_NUMBER", "1"))
+
+	scaledDown := harness.WaitFor(15*time.Second, func() bool {
+		return harness.Service().Stats().Workers.Count < 4
+	})
+
+	assert.True(t, scaledDown)
+
+	require.NoError(t, harness.SetEnv("MIN_WORKERS_NUMBER", "2"))
+}
+
+func TestIdleDispatcherSleeps(t *testing.T) {
+	before := cpuTime(t)
+
+	time.Sleep(time.Second)
+
+	// a spinning dispatcher burns the whole second
+	assert.Less(t, cpuTime(t)-before, 200*time.Millisecond)
+}
+
+func newClient(t *testing.T) *rpc.Client {
+	client, err := harness.Client()
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func addTask(t *testing.T, client *rpc.Client, groupUuid string, payload string, timeoutSeconds int) string {
+	args := rpc_workers.AddTaskArgs{
+		GroupUuid:   groupUuid,
+		TaskUuid:    uuid.New().String(),
+		UnixTimeout: int(time.Now().Unix()) + timeoutSeconds,
+		Payload:     payload,
+	}
+
+	var result rpc_workers.AddTaskResult
+
+	require.NoError(t, client.Call("WorkersServer.AddTask", args, &result))
+
+	return result.Uuid
+}
+
+func detectFinished(t *testing.T, client *rpc.Client, groupUuid string) rpc_workers.DetectFinishedTaskResult {
+	var result rpc_workers.DetectFinishedTaskResult
+
+	require.NoError(t, client.Call(
+		"WorkersServer.DetectAnyFinishedTask",
+		rpc_workers.DetectFinishedTaskArgs{GroupUuid: groupUuid},
+		&result,
+	))
+
+	return result
+}
+
+func waitFinished(t *testing.T, client *rpc.Client, groupUuid string, timeout time.Duration) rpc_workers.DetectFinishedTaskResult {
+	var result rpc_workers.DetectFinishedTaskResult
+
+	finished := harness.WaitFor(timeout, func() bool {
+		result = detectFinished(t, client, groupUuid)
+
+		return result.IsFinished
+	})
+
+	require.True(t, finished, "group ["+groupUuid+"] has no finished tasks")
+
+	return result
+}
+
+func hasEvent(t *testing.T, client *rpc.Client, taskUuid string, eventType events.Type) bool {
+	var result rpc_workers.GetTaskEventsResult
+
+	require.NoError(t, client.Call("WorkersServer.GetTaskEvents", rpc_workers.GetTaskEventsArgs{TaskUuid: taskUuid}, &result))
+
+	for _, event := range result.Events {
+		if event.Type == string(eventType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cpuTime(t *testing.T) time.Duration {
+	var usage syscall.Rusage
+
+	require.NoError(t, syscall.Getrusage(syscall.RUSAGE_SELF, &usage))
+
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+}
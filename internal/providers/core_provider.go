@@ -0,0 +1,46 @@
+package providers
+
+import (
+	"context"
+	"sparallel_server/internal/api/rpc/rpc_manager"
+	"sparallel_server/internal/api/rpc/rpc_ping_pong"
+	"sparallel_server/internal/api/rpc/rpc_proxy_mongodb"
+	"sparallel_server/internal/api/rpc/rpc_workers"
+	"sparallel_server/internal/config"
+	"sparallel_server/pkg/foundation/app"
+)
+
+type CoreProvider struct {
+}
+
+func NewCoreProvider() *CoreProvider {
+	return &CoreProvider{}
+}
+
+func (p *CoreProvider) Register(registry *app.Registry) error {
+	registry.AddRpcServer(func(ctx context.Context) app.RpcServerInterface {
+		return rpc_ping_pong.NewServer()
+	})
+
+	registry.AddRpcServer(func(ctx context.Context) app.RpcServerInterface {
+		return rpc_manager.NewServer()
+	})
+
+	registry.AddRpcServer(func(ctx context.Context) app.RpcServerInterface {
+		if !config.GetConfig().IsServeWorkers() {
+			return nil
+		}
+
+		return rpc_workers.NewServer(ctx)
+	})
+
+	registry.AddRpcServer(func(ctx context.Context) app.RpcServerInterface {
+		if !config.GetConfig().IsServeProxy() {
+			return nil
+		}
+
+		return rpc_proxy_mongodb.NewServer(ctx)
+	})
+
+	return nil
+}
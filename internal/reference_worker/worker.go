@@ -0,0 +1,116 @@
+package reference_worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Behaviours of the worker are scripted by the payload prefix
+const (
+	BehaviourEcho    = "echo:"    // echo:{data} responds with {data}
+	BehaviourSleep   = "sleep:"   // sleep:{ms}:{data} responds with {data} after {ms} milliseconds
+	BehaviourCrash   = "crash"    // exits with code 1 without a response
+	BehaviourGarbage = "garbage:" // garbage:{data} writes {data} without a length header
+)
+
+const lenOfHeaderLen = 20
+
+func Run(in io.Reader, out io.Writer, tags []string) error {
+	if tags != nil {
+		handshake, err := json.Marshal(map[string][]string{"Tags": tags})
+
+		if err != nil {
+			return errs.Err(err)
+		}
+
+		if err = write(out, string(handshake)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		payload, err := read(in)
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err = handle(payload, out); err != nil {
+			return err
+		}
+	}
+}
+
+func handle(payload string, out io.Writer) error {
+	switch {
+	case strings.HasPrefix(payload, BehaviourEcho):
+		return write(out, strings.TrimPrefix(payload, BehaviourEcho))
+	case strings.HasPrefix(payload, BehaviourSleep):
+		parts := strings.SplitN(strings.TrimPrefix(payload, BehaviourSleep), ":", 2)
+
+		milliseconds, err := strconv.Atoi(parts[0])
+
+		if err != nil {
+			return errs.Err(err)
+		}
+
+		time.Sleep(time.Duration(milliseconds) * time.Millisecond)
+
+		var data string
+
+		if len(parts) > 1 {
+			data = parts[1]
+		}
+
+		return write(out, data)
+	case payload == BehaviourCrash:
+		os.Exit(1)
+
+		return nil
+	case strings.HasPrefix(payload, BehaviourGarbage):
+		_, err := io.WriteString(out, strings.TrimPrefix(payload, BehaviourGarbage))
+
+		return errs.Err(err)
+	default:
+		return write(out, payload)
+	}
+}
+
+func read(in io.Reader) (string, error) {
+	header := make([]byte, lenOfHeaderLen)
+
+	if _, err := io.ReadFull(in, header); err != nil {
+		return "", err
+	}
+
+	length, err := strconv.Atoi(string(header))
+
+	if err != nil {
+		return "", errs.Err(err)
+	}
+
+	data := make([]byte, length)
+
+	if _, err = io.ReadFull(in, data); err != nil {
+		return "", errs.Err(err)
+	}
+
+	return string(data), nil
+}
+
+func write(out io.Writer, data string) error {
+	_, err := fmt.Fprintf(out, "%0*d%s", lenOfHeaderLen, len(data), data)
+
+	return errs.Err(err)
+}
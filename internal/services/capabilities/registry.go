@@ -0,0 +1,219 @@
+package capabilities
+
+import (
+	"reflect"
+	"runtime/debug"
+	"sparallel_server/internal/config"
+	"sync"
+)
+
+// ProtocolVersion is increased when a served method changes incompatibly
+const ProtocolVersion = 1
+
+// Version is set at build time: -ldflags "-X sparallel_server/internal/services/capabilities.Version=..."
+var Version = ""
+
+var registry *Registry
+var once sync.Once
+
+var errorType = reflect.TypeFor[error]()
+
+type Capabilities struct {
+	Version         string          `json:"version"`
+	ProtocolVersion int             `json:"protocolVersion"`
+	Build           Build           `json:"build"`
+	Services        []Service       `json:"services"`
+	Features        map[string]bool `json:"features"`
+}
+
+type Build struct {
+	GoVersion string `json:"goVersion"`
+	Revision  string `json:"revision,omitempty"`
+	Time      string `json:"time,omitempty"`
+	Modified  bool   `json:"modified"`
+}
+
+type Service struct {
+	Name    string   `json:"name"`
+	Methods []Method `json:"methods"`
+}
+
+type Method struct {
+	Name   string  `json:"name"`
+	Args   []Field `json:"args"`
+	Result []Field `json:"result"`
+}
+
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Registry struct {
+	mutex    sync.Mutex
+	services []Service
+}
+
+func GetRegistry() *Registry {
+	once.Do(func() {
+		registry = &Registry{}
+	})
+
+	return registry
+}
+
+func (r *Registry) AddServer(server any) {
+	serverType := reflect.TypeOf(server)
+
+	described := Service{
+		Name:    reflect.Indirect(reflect.ValueOf(server)).Type().Name(),
+		Methods: []Method{},
+	}
+
+	for i := 0; i < serverType.NumMethod(); i++ {
+		method := serverType.Method(i)
+
+		if !isRpcMethod(method) {
+			continue
+		}
+
+		described.Methods = append(described.Methods, Method{
+			Name:   method.Name,
+			Args:   describeFields(method.Type.In(1)),
+			Result: describeFields(method.Type.In(2)),
+		})
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.services = append(r.services, described)
+}
+
+func (r *Registry) Get() Capabilities {
+	r.mutex.Lock()
+	services := append([]Service{}, r.services...)
+	r.mutex.Unlock()
+
+	return Capabilities{
+		Version:         detectVersion(),
+		ProtocolVersion: ProtocolVersion,
+		Build:           detectBuild(),
+		Services:        services,
+		Features:        detectFeatures(),
+	}
+}
+
+func isRpcMethod(method reflect.Method) bool {
+	methodType := method.Type
+
+	if !method.IsExported() || methodType.NumIn() != 3 || methodType.NumOut() != 1 {
+		return false
+	}
+
+	return methodType.In(2).Kind() == reflect.Pointer && methodType.Out(0) == errorType
+}
+
+func describeFields(argType reflect.Type) []Field {
+	for argType.Kind() == reflect.Pointer {
+		argType = argType.Elem()
+	}
+
+	if argType.Kind() != reflect.Struct {
+		return []Field{{Type: typeName(argType)}}
+	}
+
+	fields := []Field{}
+
+	for i := 0; i < argType.NumField(); i++ {
+		field := argType.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		fields = append(fields, Field{Name: field.Name, Type: typeName(field.Type)})
+	}
+
+	return fields
+}
+
+func typeName(fieldType reflect.Type) string {
+	switch fieldType.Kind() {
+	case reflect.Pointer:
+		return typeName(fieldType.Elem())
+	case reflect.Bool:
+		return "bool"
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Slice, reflect.Array:
+		if fieldType.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+
+		return typeName(fieldType.Elem()) + "[]"
+	case reflect.Map:
+		return "map"
+	default:
+		return "object"
+	}
+}
+
+func detectVersion() string {
+	if Version != "" {
+		return Version
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+
+	return "(devel)"
+}
+
+func detectBuild() Build {
+	build := Build{}
+
+	info, ok := debug.ReadBuildInfo()
+
+	if !ok {
+		return build
+	}
+
+	build.GoVersion = info.GoVersion
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.Revision = setting.Value
+		case "vcs.time":
+			build.Time = setting.Value
+		case "vcs.modified":
+			build.Modified = setting.Value == "true"
+		}
+	}
+
+	return build
+}
+
+func detectFeatures() map[string]bool {
+	cfg := config.GetConfig()
+
+	return map[string]bool{
+		"workers":          cfg.IsServeWorkers(),
+		"mongodb_proxy":    cfg.IsServeProxy(),
+		"subscription":     cfg.IsServeWorkers() && cfg.GetSubscriptionPort() != "",
+		"http_gateway":     cfg.GetHttpPort() != "",
+		"grpc":             cfg.GetGrpcPort() != "",
+		"tls":              cfg.GetRpcTlsCertFile() != "",
+		"mutual_tls":       cfg.GetRpcTlsClientCaFile() != "",
+		"acl":              cfg.GetRpcAclFile() != "",
+		"chaos":            cfg.IsChaosMode(),
+		"worker_handshake": cfg.IsWorkerHandshake(),
+	}
+}
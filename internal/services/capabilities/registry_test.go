@@ -0,0 +1,62 @@
+package capabilities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TestArgs struct {
+	Name    string
+	Tags    []string
+	Payload []byte
+	Rate    float64
+	hidden  int
+}
+
+type TestResult struct {
+	Answer string
+}
+
+type TestServer struct {
+}
+
+func (s *TestServer) Call(_ *TestArgs, _ *TestResult) error {
+	return nil
+}
+
+func (s *TestServer) Close() error {
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	registry := &Registry{}
+
+	registry.AddServer(&TestServer{})
+
+	result := registry.Get()
+
+	assert.Equal(t, ProtocolVersion, result.ProtocolVersion)
+	assert.NotEmpty(t, result.Version)
+
+	assert.Equal(
+		t,
+		[]Service{
+			{
+				Name: "TestServer",
+				Methods: []Method{
+					{
+						Name: "Call",
+						Args: []Field{
+							{Name: "Name", Type: "string"},
+							{Name: "Tags", Type: "string[]"},
+							{Name: "Payload", Type: "bytes"},
+							{Name: "Rate", Type: "float"},
+						},
+						Result: []Field{{Name: "Answer", Type: "string"}},
+					},
+				},
+			},
+		},
+		result.Services,
+	)
+}
@@ -0,0 +1,52 @@
+package connections
+
+import (
+	"net"
+	"net/rpc"
+)
+
+type Codec struct {
+	rpc.ServerCodec
+
+	tracker *Tracker
+	conn    net.Conn
+}
+
+func (c *Codec) ReadRequestHeader(request *rpc.Request) error {
+	err := c.ServerCodec.ReadRequestHeader(request)
+
+	// net/rpc responds to every call with a read header
+	if err == nil {
+		c.tracker.inFlight.Add(1)
+	}
+
+	return err
+}
+
+func (c *Codec) ReadRequestBody(args any) error {
+	err := c.ServerCodec.ReadRequestBody(args)
+
+	if err != nil {
+		return err
+	}
+
+	if c.tracker.draining.Load() {
+		c.tracker.rejected.Add(1)
+
+		return ErrShuttingDown
+	}
+
+	return nil
+}
+
+func (c *Codec) WriteResponse(response *rpc.Response, body any) error {
+	defer c.tracker.inFlight.Add(-1)
+
+	return c.ServerCodec.WriteResponse(response, body)
+}
+
+func (c *Codec) Close() error {
+	c.tracker.untrack(c.conn)
+
+	return c.ServerCodec.Close()
+}
@@ -0,0 +1,146 @@
+package connections
+
+import (
+	"errors"
+	"net"
+	"net/rpc"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const idleCheckInterval = 10 * time.Millisecond
+
+// ErrShuttingDown is returned to the calls read after Drain, clients get it as it is
+var ErrShuttingDown = errors.New("server is shutting down")
+
+var tracker *Tracker
+var once sync.Once
+
+type Tracker struct {
+	mutex       sync.Mutex
+	connections map[net.Conn]bool
+	methods     map[string]*MethodStats
+
+	accepted atomic.Uint64
+	inFlight atomic.Int64
+	rejected atomic.Uint64
+	draining atomic.Bool
+}
+
+type Stats struct {
+	Open     int
+	Accepted uint64
+	InFlight int64
+	Rejected uint64
+	Draining bool
+	Methods  map[string]MethodStats
+}
+
+type MethodStats struct {
+	Calls  uint64
+	Errors uint64
+}
+
+func GetTracker() *Tracker {
+	once.Do(func() {
+		tracker = newTracker()
+	})
+
+	return tracker
+}
+
+func newTracker() *Tracker {
+	return &Tracker{
+		connections: make(map[net.Conn]bool),
+		methods:     make(map[string]*MethodStats),
+	}
+}
+
+func (t *Tracker) Track(conn net.Conn, codec rpc.ServerCodec) *Codec {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.connections[conn] = true
+
+	t.accepted.Add(1)
+
+	return &Codec{
+		ServerCodec: codec,
+		tracker:     t,
+		conn:        conn,
+	}
+}
+
+func (t *Tracker) Drain() {
+	t.draining.Store(true)
+}
+
+func (t *Tracker) WaitIdle(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+
+	for t.inFlight.Load() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+
+		time.Sleep(idleCheckInterval)
+	}
+
+	return true
+}
+
+func (t *Tracker) CloseAll() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for conn := range t.connections {
+		_ = conn.Close()
+	}
+}
+
+func (t *Tracker) CountCall(serviceMethod string, failed bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	method, exists := t.methods[serviceMethod]
+
+	if !exists {
+		method = &MethodStats{}
+
+		t.methods[serviceMethod] = method
+	}
+
+	method.Calls++
+
+	if failed {
+		method.Errors++
+	}
+}
+
+func (t *Tracker) Stats() Stats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	stats := Stats{
+		Open:     len(t.connections),
+		Accepted: t.accepted.Load(),
+		InFlight: t.inFlight.Load(),
+		Rejected: t.rejected.Load(),
+		Draining: t.draining.Load(),
+		Methods:  make(map[string]MethodStats, len(t.methods)),
+	}
+
+	for serviceMethod, method := range t.methods {
+		stats.Methods[serviceMethod] = *method
+	}
+
+	return stats
+}
+
+func (t *Tracker) untrack(conn net.Conn) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.connections, conn)
+}
@@ -0,0 +1,90 @@
+package connections
+
+import (
+	"net"
+	"net/rpc"
+	"testing"
+	"time"
+
+	goridgeRpc "github.com/roadrunner-server/goridge/v3/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SlowServer struct {
+	release chan struct{}
+}
+
+type SlowArgs struct {
+	Wait bool
+}
+
+type SlowReply struct {
+	Answer string
+}
+
+func (s *SlowServer) Call(args *SlowArgs, reply *SlowReply) error {
+	if args.Wait {
+		<-s.release
+	}
+
+	reply.Answer = "Ok"
+
+	return nil
+}
+
+func TestTracker_Drain(t *testing.T) {
+	tracker := newTracker()
+
+	slow := &SlowServer{release: make(chan struct{})}
+
+	server := rpc.NewServer()
+
+	require.NoError(t, server.Register(slow))
+
+	serverConn, clientConn := net.Pipe()
+
+	go server.ServeCodec(tracker.Track(serverConn, goridgeRpc.NewCodec(serverConn)))
+
+	client := rpc.NewClientWithCodec(goridgeRpc.NewClientCodec(clientConn))
+
+	var reply SlowReply
+
+	require.NoError(t, client.Call("SlowServer.Call", SlowArgs{}, &reply))
+
+	inFlight := client.Go("SlowServer.Call", SlowArgs{Wait: true}, &SlowReply{}, nil)
+
+	require.Eventually(t, func() bool {
+		return tracker.Stats().InFlight == 1
+	}, time.Second, time.Millisecond)
+
+	tracker.Drain()
+
+	err := client.Call("SlowServer.Call", SlowArgs{}, &reply)
+
+	assert.ErrorContains(t, err, ErrShuttingDown.Error())
+
+	assert.False(t, tracker.WaitIdle(20*time.Millisecond))
+
+	close(slow.release)
+
+	assert.True(t, tracker.WaitIdle(time.Second))
+
+	call := <-inFlight.Done
+
+	assert.NoError(t, call.Error)
+
+	stats := tracker.Stats()
+
+	assert.Equal(t, 1, stats.Open)
+	assert.Equal(t, uint64(1), stats.Accepted)
+	assert.Equal(t, int64(0), stats.InFlight)
+	assert.Equal(t, uint64(1), stats.Rejected)
+	assert.True(t, stats.Draining)
+
+	tracker.CloseAll()
+
+	require.Eventually(t, func() bool {
+		return tracker.Stats().Open == 0
+	}, time.Second, time.Millisecond)
+}
@@ -2,9 +2,11 @@ package stats_service
 
 import (
 	"runtime"
+	"sparallel_server/internal/services/connections"
 	"sparallel_server/internal/services/proxy_server/mongodb_proxy"
 	"sparallel_server/internal/services/proxy_server/mongodb_proxy/mongodb_proxy_objects"
 	"sparallel_server/internal/services/workers_server"
+	"sparallel_server/pkg/foundation/app"
 	"sync"
 	"time"
 )
@@ -26,8 +28,10 @@ type Service struct {
 type CombinedStats struct {
 	DateTime     time.Time                           `json:"dateTime"`
 	System       SystemStats                         `json:"system"`
+	Connections  connections.Stats                   `json:"connections"`
 	Workers      *workers_server.WorkersServerStats  `json:"workers,omitempty"`
 	MongodbProxy *mongodb_proxy_objects.ServiceStats `json:"mongodb_proxy,omitempty"`
+	Sections     map[string]any                      `json:"sections,omitempty"`
 }
 
 func NewService() *Service {
@@ -57,6 +61,8 @@ func (s *Service) Get() CombinedStats {
 
 	combined.System = sysStats
 
+	combined.Connections = connections.GetTracker().Stats()
+
 	workersService := workers_server.GetService()
 
 	if workersService != nil {
@@ -73,5 +79,13 @@ func (s *Service) Get() CombinedStats {
 		combined.MongodbProxy = &mongodbProxyServiceStats
 	}
 
+	for _, section := range app.GetRegistry().GetStatsSections() {
+		if combined.Sections == nil {
+			combined.Sections = make(map[string]any)
+		}
+
+		combined.Sections[section.Name] = section.Collect()
+	}
+
 	return combined
 }
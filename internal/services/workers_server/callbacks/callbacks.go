@@ -0,0 +1,305 @@
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sparallel_server/pkg/foundation/errs"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const unixScheme = "unix://"
+
+const (
+	unixIdleConnTimeout = 90 * time.Second
+	maxUnixClients      = 64
+)
+
+func NewCallbacks(
+	targets *Targets,
+	secret string,
+	maxAttempts int,
+	backoff time.Duration,
+	timeout time.Duration,
+	workersNumber int,
+	queueSize int,
+) *Callbacks {
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	if workersNumber <= 0 {
+		workersNumber = 1
+	}
+
+	callbacks := &Callbacks{
+		targets:     targets,
+		secret:      secret,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		httpClient: &http.Client{
+			Timeout:       timeout,
+			CheckRedirect: refuseRedirect,
+		},
+		unixClients: make(map[string]*unixClient),
+		queue:       make(chan *queuedDelivery, max(queueSize, 0)),
+		statuses:    make(map[string]*Status),
+	}
+
+	for i := 0; i < workersNumber; i++ {
+		go callbacks.work()
+	}
+
+	return callbacks
+}
+
+// Enqueue delivers the result in background, [done] is called with the outcome.
+// The delivery fails at once when the queue is full, so a slow target can't pile up deliveries.
+func (c *Callbacks) Enqueue(delivery *Delivery, done func(err error)) {
+	c.setStatus(delivery, StatePending, 0, "")
+
+	select {
+	case c.queue <- &queuedDelivery{delivery: delivery, done: done}:
+	default:
+		err := errors.New("callback queue is full")
+
+		c.setStatus(delivery, StateFailed, 0, err.Error())
+
+		done(err)
+	}
+}
+
+func (c *Callbacks) Deliver(delivery *Delivery) error {
+	c.setStatus(delivery, StatePending, 0, "")
+
+	data, err := json.Marshal(body{
+		GroupUuid: delivery.GroupUuid,
+		TaskUuid:  delivery.TaskUuid,
+		IsError:   delivery.IsError,
+		IsTimeout: delivery.IsTimeout,
+		Response:  delivery.Response,
+	})
+
+	if err != nil {
+		c.setStatus(delivery, StateFailed, 0, err.Error())
+
+		return errs.Err(err)
+	}
+
+	pause := c.backoff
+
+	for attempt := 1; attempt <= c.maxAttempts; attempt++ {
+		err = c.post(delivery.Target, data)
+
+		if err == nil {
+			slog.Debug("Callback of task [" + delivery.TaskUuid + "] delivered")
+
+			c.setStatus(delivery, StateDelivered, attempt, "")
+
+			return nil
+		}
+
+		slog.Warn(
+			"Callback of task [" + delivery.TaskUuid + "] attempt [" + strconv.Itoa(attempt) + "] failed: " + err.Error(),
+		)
+
+		if attempt == c.maxAttempts {
+			c.setStatus(delivery, StateFailed, attempt, err.Error())
+
+			return err
+		}
+
+		c.setStatus(delivery, StatePending, attempt, err.Error())
+
+		time.Sleep(pause)
+
+		pause *= 2
+	}
+
+	return err
+}
+
+func (c *Callbacks) Validate(target string) error {
+	return c.targets.Validate(target)
+}
+
+func (c *Callbacks) GetStatus(taskUuid string) *Status {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	status, exists := c.statuses[taskUuid]
+
+	if !exists {
+		return nil
+	}
+
+	statusCopy := *status
+
+	return &statusCopy
+}
+
+func (c *Callbacks) FlushStale(ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for taskUuid, status := range c.statuses {
+		if status.State != StatePending && time.Since(status.UpdatedAt) > ttl {
+			delete(c.statuses, taskUuid)
+		}
+	}
+}
+
+func (c *Callbacks) Sign(timestamp string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(c.secret))
+
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(data)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *Callbacks) work() {
+	for queued := range c.queue {
+		delivery := queued.delivery
+
+		response, err := payloads.Unpack(delivery.Response, delivery.ResponseEncoding)
+
+		if err != nil {
+			c.setStatus(delivery, StateFailed, 0, err.Error())
+
+			queued.done(err)
+
+			continue
+		}
+
+		delivery.Response = response
+		delivery.ResponseEncoding = payloads.EncodingPlain
+
+		queued.done(c.Deliver(delivery))
+	}
+}
+
+func (c *Callbacks) post(target string, data []byte) error {
+	requestUrl := target
+	client := c.httpClient
+
+	if strings.HasPrefix(target, unixScheme) {
+		requestUrl = "http://unix/"
+		client = c.unixClient(strings.TrimPrefix(target, unixScheme))
+	}
+
+	request, err := http.NewRequest(http.MethodPost, requestUrl, bytes.NewReader(data))
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(TimestampHeader, timestamp)
+
+	if c.secret != "" {
+		request.Header.Set(SignatureHeader, "sha256="+c.Sign(timestamp, data))
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	_ = response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errors.New("unexpected status [" + response.Status + "]")
+	}
+
+	return nil
+}
+
+func (c *Callbacks) unixClient(socketPath string) *http.Client {
+	c.unixClientsMutex.Lock()
+	defer c.unixClientsMutex.Unlock()
+
+	now := time.Now()
+
+	if cached, exists := c.unixClients[socketPath]; exists {
+		cached.usedAt = now
+
+		return cached.client
+	}
+
+	c.evictUnixClients(now)
+
+	client := &http.Client{
+		Timeout:       c.httpClient.Timeout,
+		CheckRedirect: refuseRedirect,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+			IdleConnTimeout: unixIdleConnTimeout,
+		},
+	}
+
+	c.unixClients[socketPath] = &unixClient{client: client, usedAt: now}
+
+	return client
+}
+
+func (c *Callbacks) evictUnixClients(now time.Time) {
+	var oldestPath string
+	var oldest *unixClient
+
+	for socketPath, cached := range c.unixClients {
+		if now.Sub(cached.usedAt) > unixIdleConnTimeout {
+			cached.client.CloseIdleConnections()
+
+			delete(c.unixClients, socketPath)
+
+			continue
+		}
+
+		if oldest == nil || cached.usedAt.Before(oldest.usedAt) {
+			oldestPath = socketPath
+			oldest = cached
+		}
+	}
+
+	if len(c.unixClients) >= maxUnixClients && oldest != nil {
+		oldest.client.CloseIdleConnections()
+
+		delete(c.unixClients, oldestPath)
+	}
+}
+
+// refuseRedirect keeps the delivery on the validated target, a redirect fails it by its status
+func refuseRedirect(_ *http.Request, _ []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+func (c *Callbacks) setStatus(delivery *Delivery, state State, attempts int, lastError string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.statuses[delivery.TaskUuid] = &Status{
+		Target:    delivery.Target,
+		State:     state,
+		Attempts:  attempts,
+		LastError: lastError,
+		UpdatedAt: time.Now(),
+	}
+}
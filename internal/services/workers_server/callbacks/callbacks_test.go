@@ -0,0 +1,197 @@
+package callbacks
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliverRetriesAndSigns(t *testing.T) {
+	callbacks := NewCallbacks(NewTargets(nil, ""), "secret", 3, time.Millisecond, time.Second, 1, 1)
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		data, _ := io.ReadAll(request.Body)
+
+		signature := "sha256=" + callbacks.Sign(request.Header.Get(TimestampHeader), data)
+
+		assert.Equal(t, signature, request.Header.Get(SignatureHeader))
+
+		if requests.Add(1) < 2 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := callbacks.Deliver(&Delivery{Target: server.URL, TaskUuid: "a1", Response: "ok"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+
+	status := callbacks.GetStatus("a1")
+
+	assert.Equal(t, StateDelivered, status.State)
+	assert.Equal(t, 2, status.Attempts)
+}
+
+func TestDeliverFails(t *testing.T) {
+	callbacks := NewCallbacks(NewTargets(nil, ""), "", 2, time.Millisecond, time.Second, 1, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := callbacks.Deliver(&Delivery{Target: server.URL, TaskUuid: "a1"})
+
+	assert.Error(t, err)
+
+	status := callbacks.GetStatus("a1")
+
+	assert.Equal(t, StateFailed, status.State)
+	assert.Equal(t, 2, status.Attempts)
+}
+
+func TestTargets_Validate(t *testing.T) {
+	targets := NewTargets([]string{"hooks.internal"}, "/run/callbacks")
+
+	cases := map[string]bool{
+		"http://localhost:8080/done":         true,
+		"http://127.0.0.1:8080/done":         true,
+		"https://[::1]/done":                 true,
+		"https://HOOKS.internal/done":        true,
+		"http://169.254.169.254/latest":      false,
+		"http://10.0.0.1/done":               false,
+		"http://example.com/done":            false,
+		"ftp://localhost":                    false,
+		"unix:///run/callbacks/app.sock":     true,
+		"unix:///run/callbacks/../app.sock":  false,
+		"unix:///run/docker.sock":            false,
+		"unix://run/callbacks/app.sock":      false,
+		"unix://":                            false,
+		"unix:///run/callbacks/a/b/app.sock": true,
+	}
+
+	for target, valid := range cases {
+		if valid {
+			assert.NoError(t, targets.Validate(target), target)
+		} else {
+			assert.Error(t, targets.Validate(target), target)
+		}
+	}
+
+	assert.Error(t, NewTargets(nil, "").Validate("unix:///run/callbacks/app.sock"), "sockets are disabled without a directory")
+}
+
+func TestDeliverDoesNotFollowRedirects(t *testing.T) {
+	var redirected atomic.Bool
+
+	target := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		redirected.Store(true)
+	}))
+	defer target.Close()
+
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	callbacks := NewCallbacks(NewTargets(nil, ""), "", 1, time.Millisecond, time.Second, 1, 1)
+
+	assert.Error(t, callbacks.Deliver(&Delivery{Target: server.URL, TaskUuid: "a1"}))
+	assert.False(t, redirected.Load())
+}
+
+func TestUnixClientsAreEvicted(t *testing.T) {
+	callbacks := NewCallbacks(NewTargets(nil, ""), "", 1, time.Millisecond, time.Second, 1, 1)
+
+	for i := 0; i < maxUnixClients*2; i++ {
+		callbacks.unixClient("/run/callbacks/" + strconv.Itoa(i) + ".sock")
+	}
+
+	assert.Len(t, callbacks.unixClients, maxUnixClients)
+
+	for _, cached := range callbacks.unixClients {
+		cached.usedAt = time.Now().Add(-2 * unixIdleConnTimeout)
+	}
+
+	callbacks.unixClient("/run/callbacks/fresh.sock")
+
+	assert.Len(t, callbacks.unixClients, 1)
+}
+
+func TestDeliverReusesUnixSocketConnections(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "callback.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+
+	assert.NoError(t, err)
+
+	var connections atomic.Int32
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+	server.Listener = listener
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	callbacks := NewCallbacks(NewTargets(nil, filepath.Dir(socketPath)), "", 1, time.Millisecond, time.Second, 1, 1)
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, callbacks.Deliver(&Delivery{Target: "unix://" + socketPath, TaskUuid: "a1"}))
+	}
+
+	assert.Equal(t, int32(1), connections.Load())
+}
+
+func TestEnqueueFailsWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		<-release
+
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	callbacks := NewCallbacks(NewTargets(nil, ""), "", 1, time.Millisecond, time.Second, 1, 1)
+
+	results := make(chan error, 3)
+
+	for _, taskUuid := range []string{"a1", "a2", "a3"} {
+		callbacks.Enqueue(&Delivery{Target: server.URL, TaskUuid: taskUuid}, func(err error) {
+			results <- err
+		})
+
+		// the worker takes the first delivery before the next ones are queued
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	select {
+	case err := <-results:
+		assert.EqualError(t, err, "callback queue is full")
+	case <-time.After(time.Second):
+		assert.Fail(t, "a delivery is not rejected")
+	}
+
+	assert.Equal(t, StateFailed, callbacks.GetStatus("a3").State)
+	assert.Equal(t, StatePending, callbacks.GetStatus("a2").State)
+}
@@ -0,0 +1,74 @@
+package callbacks
+
+import (
+	"net/http"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sync"
+	"time"
+)
+
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateDelivered State = "delivered"
+	StateFailed    State = "failed"
+)
+
+const (
+	SignatureHeader = "X-Sparallel-Signature"
+	TimestampHeader = "X-Sparallel-Timestamp"
+)
+
+type Callbacks struct {
+	targets     *Targets
+	secret      string
+	maxAttempts int
+	backoff     time.Duration
+
+	httpClient *http.Client
+
+	unixClientsMutex sync.Mutex
+	unixClients      map[string]*unixClient
+
+	queue chan *queuedDelivery
+
+	mutex    sync.Mutex
+	statuses map[string]*Status
+}
+
+type Status struct {
+	Target    string
+	State     State
+	Attempts  int
+	LastError string
+	UpdatedAt time.Time
+}
+
+type Delivery struct {
+	Target           string
+	GroupUuid        string
+	TaskUuid         string
+	IsError          bool
+	IsTimeout        bool
+	Response         string
+	ResponseEncoding payloads.Encoding // the response is unpacked by the delivery worker
+}
+
+type unixClient struct {
+	client *http.Client
+	usedAt time.Time
+}
+
+type queuedDelivery struct {
+	delivery *Delivery
+	done     func(err error)
+}
+
+type body struct {
+	GroupUuid string
+	TaskUuid  string
+	IsError   bool
+	IsTimeout bool
+	Response  string
+}
@@ -0,0 +1,90 @@
+package callbacks
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"path/filepath"
+	"sparallel_server/pkg/foundation/errs"
+	"strings"
+)
+
+// Targets keeps the callbacks on local endpoints: loopback or allowed hosts and sockets of the socket directory
+type Targets struct {
+	allowedHosts map[string]bool
+	socketDir    string
+}
+
+func NewTargets(allowedHosts []string, socketDir string) *Targets {
+	targets := &Targets{
+		allowedHosts: make(map[string]bool, len(allowedHosts)),
+	}
+
+	for _, host := range allowedHosts {
+		targets.allowedHosts[strings.ToLower(host)] = true
+	}
+
+	if socketDir != "" {
+		if absolute, err := filepath.Abs(socketDir); err == nil {
+			targets.socketDir = absolute
+		}
+	}
+
+	return targets
+}
+
+func (t *Targets) Validate(target string) error {
+	if strings.HasPrefix(target, unixScheme) {
+		return t.validateSocket(strings.TrimPrefix(target, unixScheme))
+	}
+
+	parsed, err := url.Parse(target)
+
+	if err != nil {
+		return errs.Err(err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("callback [" + target + "] is not an http(s) URL or unix socket path")
+	}
+
+	if !t.isAllowedHost(parsed.Hostname()) {
+		return errors.New("callback host [" + parsed.Hostname() + "] is not a loopback or allowed host")
+	}
+
+	return nil
+}
+
+func (t *Targets) validateSocket(path string) error {
+	if path == "" {
+		return errors.New("empty unix socket path of callback")
+	}
+
+	if t.socketDir == "" {
+		return errors.New("unix socket callbacks are disabled")
+	}
+
+	if !filepath.IsAbs(path) {
+		return errors.New("callback socket [" + path + "] is not an absolute path")
+	}
+
+	relative, err := filepath.Rel(t.socketDir, filepath.Clean(path))
+
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return errors.New("callback socket [" + path + "] is outside of the callback socket directory")
+	}
+
+	return nil
+}
+
+func (t *Targets) isAllowedHost(host string) bool {
+	host = strings.ToLower(host)
+
+	if host == "localhost" || t.allowedHosts[host] {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,121 @@
+package chaos
+
+import (
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+)
+
+func NewChaos(enabled bool, dispatchDelay time.Duration) *Chaos {
+	return &Chaos{
+		enabled:       enabled,
+		dispatchDelay: dispatchDelay,
+		random:        rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), 0)),
+		rates:         make(map[Fault]float64),
+		injected:      make(map[Fault]int),
+	}
+}
+
+func (c *Chaos) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *Chaos) Set(fault Fault, rate float64) error {
+	if !c.enabled {
+		return errors.New("chaos mode is disabled")
+	}
+
+	if !slices.Contains(Faults, fault) {
+		return errors.New("unknown fault [" + string(fault) + "]")
+	}
+
+	if rate < 0 || rate > 1 {
+		return errors.New("rate of fault [" + string(fault) + "] must be from 0 to 1")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if rate == 0 {
+		delete(c.rates, fault)
+	} else {
+		c.rates[fault] = rate
+	}
+
+	slog.Warn("Chaos fault [" + string(fault) + "] rate set to [" + strconv.FormatFloat(rate, 'f', -1, 64) + "]")
+
+	return nil
+}
+
+func (c *Chaos) Roll(fault Fault) bool {
+	if !c.enabled {
+		return false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	rate, exists := c.rates[fault]
+
+	if !exists || c.random.Float64() >= rate {
+		return false
+	}
+
+	c.injected[fault]++
+
+	slog.Warn("Chaos fault [" + string(fault) + "] injected")
+
+	return true
+}
+
+func (c *Chaos) DelayDispatch() {
+	if c.Roll(FaultDelayDispatch) {
+		time.Sleep(c.dispatchDelay)
+	}
+}
+
+func (c *Chaos) MangleResponse(response string) string {
+	if response == "" {
+		return response
+	}
+
+	data := []byte(response)
+
+	if c.Roll(FaultTruncateResponse) {
+		data = data[:len(data)/2]
+	}
+
+	if len(data) > 0 && c.Roll(FaultCorruptResponse) {
+		c.mutex.Lock()
+
+		for i := c.random.IntN(min(10, len(data))); i < len(data); i += 10 {
+			data[i] ^= 0xff
+		}
+
+		c.mutex.Unlock()
+	}
+
+	return string(data)
+}
+
+func (c *Chaos) Stats() ChaosStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := ChaosStats{
+		Enabled: c.enabled,
+		Faults:  make(map[Fault]FaultStats, len(Faults)),
+	}
+
+	for _, fault := range Faults {
+		stats.Faults[fault] = FaultStats{
+			Rate:          c.rates[fault],
+			InjectedCount: c.injected[fault],
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,51 @@
+package chaos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabled(t *testing.T) {
+	chaos := NewChaos(false, time.Millisecond)
+
+	assert.Error(t, chaos.Set(FaultKillWorker, 1))
+	assert.False(t, chaos.Roll(FaultKillWorker))
+}
+
+func TestRoll(t *testing.T) {
+	chaos := NewChaos(true, time.Millisecond)
+
+	assert.Error(t, chaos.Set("unknown", 1))
+	assert.Error(t, chaos.Set(FaultFailWrite, 2))
+
+	assert.NoError(t, chaos.Set(FaultFailWrite, 1))
+
+	assert.True(t, chaos.Roll(FaultFailWrite))
+	assert.False(t, chaos.Roll(FaultForceTimeout))
+
+	assert.NoError(t, chaos.Set(FaultFailWrite, 0))
+
+	assert.False(t, chaos.Roll(FaultFailWrite))
+
+	stats := chaos.Stats()
+
+	assert.Equal(t, 1, stats.Faults[FaultFailWrite].InjectedCount)
+	assert.Equal(t, float64(0), stats.Faults[FaultFailWrite].Rate)
+}
+
+func TestMangleResponse(t *testing.T) {
+	chaos := NewChaos(true, time.Millisecond)
+
+	data := "0123456789"
+
+	assert.NoError(t, chaos.Set(FaultTruncateResponse, 1))
+
+	assert.Equal(t, "01234", chaos.MangleResponse(data))
+
+	assert.NoError(t, chaos.Set(FaultTruncateResponse, 0))
+	assert.NoError(t, chaos.Set(FaultCorruptResponse, 1))
+
+	assert.NotEqual(t, data, chaos.MangleResponse(data))
+}
@@ -0,0 +1,49 @@
+package chaos
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+type Fault string
+
+const (
+	FaultKillWorker       Fault = "kill_worker"
+	FaultDelayDispatch    Fault = "delay_dispatch"
+	FaultCorruptResponse  Fault = "corrupt_response"
+	FaultTruncateResponse Fault = "truncate_response"
+	FaultFailWrite        Fault = "fail_write"
+	FaultForceTimeout     Fault = "force_timeout"
+)
+
+var Faults = []Fault{
+	FaultKillWorker,
+	FaultDelayDispatch,
+	FaultCorruptResponse,
+	FaultTruncateResponse,
+	FaultFailWrite,
+	FaultForceTimeout,
+}
+
+// Chaos injects faults into handling of tasks with configured rates from 0 to 1.
+// It does nothing unless it is enabled by the config.
+type Chaos struct {
+	enabled       bool
+	dispatchDelay time.Duration
+
+	mutex    sync.Mutex
+	random   *rand.Rand
+	rates    map[Fault]float64
+	injected map[Fault]int
+}
+
+type FaultStats struct {
+	Rate          float64
+	InjectedCount int
+}
+
+type ChaosStats struct {
+	Enabled bool
+	Faults  map[Fault]FaultStats
+}
@@ -0,0 +1,39 @@
+package workers_server
+
+import (
+	"errors"
+)
+
+// The kinds of the errors returned to the clients, they are matched by errors.Is
+var (
+	ErrClosing         = errors.New("service is closing")
+	ErrNotServed       = errors.New("workers server is not served")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrNotFound        = errors.New("not found")
+)
+
+// clientError keeps the message of [err] and is matched as [kind]
+type clientError struct {
+	kind error
+	err  error
+}
+
+func (e *clientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *clientError) Unwrap() error {
+	return e.err
+}
+
+func (e *clientError) Is(target error) bool {
+	return target == e.kind
+}
+
+func invalidArgument(err error) error {
+	return &clientError{kind: ErrInvalidArgument, err: err}
+}
+
+func notFound(err error) error {
+	return &clientError{kind: ErrNotFound, err: err}
+}
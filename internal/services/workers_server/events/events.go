@@ -0,0 +1,128 @@
+package events
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sparallel_server/pkg/foundation/errs"
+	"time"
+)
+
+func NewEvents(limit int, filePath string) (*Events, error) {
+	if limit <= 0 {
+		limit = 10000
+	}
+
+	e := &Events{
+		limit: limit,
+		tasks: make(map[string][]*Event),
+		order: make([]string, 0, limit),
+	}
+
+	if filePath != "" {
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			return nil, errs.Err(err)
+		}
+
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+
+		if err != nil {
+			return nil, errs.Err(err)
+		}
+
+		e.file = file
+	}
+
+	return e, nil
+}
+
+func (e *Events) Add(groupUuid string, taskUuid string, eventType Type, pid int, message string) {
+	event := &Event{
+		Time:      time.Now(),
+		GroupUuid: groupUuid,
+		TaskUuid:  taskUuid,
+		Type:      eventType,
+		Pid:       pid,
+		Message:   message,
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	taskEvents, exists := e.tasks[taskUuid]
+
+	if !exists {
+		e.remember(taskUuid)
+	}
+
+	if len(taskEvents) >= eventsPerTaskLimit {
+		taskEvents = taskEvents[1:]
+	}
+
+	e.tasks[taskUuid] = append(taskEvents, event)
+
+	if e.file != nil {
+		e.write(event)
+	}
+}
+
+func (e *Events) Get(taskUuid string) []Event {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	taskEvents := e.tasks[taskUuid]
+
+	result := make([]Event, 0, len(taskEvents))
+
+	for _, event := range taskEvents {
+		result = append(result, *event)
+	}
+
+	return result
+}
+
+func (e *Events) Close() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+
+	err := e.file.Close()
+
+	e.file = nil
+
+	return errs.Err(err)
+}
+
+func (e *Events) remember(taskUuid string) {
+	if len(e.order) < e.limit {
+		e.order = append(e.order, taskUuid)
+
+		return
+	}
+
+	delete(e.tasks, e.order[e.next])
+
+	e.order[e.next] = taskUuid
+
+	e.next = (e.next + 1) % e.limit
+}
+
+func (e *Events) write(event *Event) {
+	data, err := json.Marshal(event)
+
+	if err != nil {
+		slog.Error("Failed to marshal task event: " + err.Error())
+
+		return
+	}
+
+	_, err = e.file.Write(append(data, '\n'))
+
+	if err != nil {
+		slog.Error("Failed to write task event: " + err.Error())
+	}
+}
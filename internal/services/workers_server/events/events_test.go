@@ -0,0 +1,27 @@
+package events
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEvents_Limit(t *testing.T) {
+	taskEvents, err := NewEvents(2, "")
+
+	assert.NoError(t, err)
+
+	taskEvents.Add("g", "1", TypeAdded, 0, "")
+	taskEvents.Add("g", "1", TypeDispatched, 10, "")
+	taskEvents.Add("g", "2", TypeAdded, 0, "")
+	taskEvents.Add("g", "3", TypeAdded, 0, "")
+
+	assert.Empty(t, taskEvents.Get("1"))
+	assert.Len(t, taskEvents.Get("2"), 1)
+	assert.Len(t, taskEvents.Get("3"), 1)
+
+	for i := 0; i < eventsPerTaskLimit+5; i++ {
+		taskEvents.Add("g", "3", TypeReAdded, 0, "")
+	}
+
+	assert.Len(t, taskEvents.Get("3"), eventsPerTaskLimit)
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+type Type string
+
+const (
+	TypeAdded       Type = "added"
+	TypeReAdded     Type = "re_added"
+	TypeDispatched  Type = "dispatched"
+	TypeResponse    Type = "response"
+	TypeError       Type = "error"
+	TypeTimeout     Type = "timeout"
+	TypeCollected   Type = "collected"
+	TypeFlushed     Type = "flushed"
+	TypeEvicted     Type = "evicted"
+	TypeCancelled   Type = "cancelled"
+	TypeDelivered   Type = "delivered"
+	TypeUndelivered Type = "undelivered"
+)
+
+const eventsPerTaskLimit = 32
+
+type Events struct {
+	mutex sync.Mutex
+
+	limit int
+	tasks map[string][]*Event
+	order []string
+	next  int
+
+	file *os.File
+}
+
+type Event struct {
+	Time      time.Time `json:"time"`
+	GroupUuid string    `json:"groupUuid"`
+	TaskUuid  string    `json:"taskUuid"`
+	Type      Type      `json:"type"`
+	Pid       int       `json:"pid,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
@@ -0,0 +1,112 @@
+package limits
+
+import (
+	"sync"
+	"time"
+)
+
+type Bucket struct {
+	mutex     sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	updatedAt time.Time
+	usedAt    time.Time
+	implicit  bool // set from the defaults of tasks, not by an operator
+}
+
+type BucketStats struct {
+	Rate   float64
+	Burst  float64
+	Tokens float64
+}
+
+func NewBucket(rate float64, burst int) *Bucket {
+	b := &Bucket{
+		updatedAt: time.Now(),
+		usedAt:    time.Now(),
+	}
+
+	b.set(rate, burst)
+
+	b.tokens = b.burst
+
+	return b
+}
+
+func (b *Bucket) Set(rate float64, burst int) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+
+	b.set(rate, burst)
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *Bucket) Stats() BucketStats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+
+	return BucketStats{
+		Rate:   b.rate,
+		Burst:  b.burst,
+		Tokens: b.tokens,
+	}
+}
+
+func (b *Bucket) hasToken() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+
+	return b.tokens >= 1
+}
+
+func (b *Bucket) take() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.tokens -= 1
+	b.usedAt = time.Now()
+}
+
+func (b *Bucket) giveBack() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+
+	b.tokens += 1
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *Bucket) set(rate float64, burst int) {
+	b.rate = rate
+	b.burst = float64(burst)
+
+	if b.burst < 1 {
+		b.burst = 1
+	}
+}
+
+func (b *Bucket) refill() {
+	now := time.Now()
+
+	b.tokens += now.Sub(b.updatedAt).Seconds() * b.rate
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.updatedAt = now
+}
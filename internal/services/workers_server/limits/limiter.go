@@ -0,0 +1,194 @@
+package limits
+
+import (
+	"sparallel_server/internal/services/workers_server/tasks"
+	"sync"
+	"time"
+)
+
+const (
+	ScopeGlobal = "global"
+	ScopeGroup  = "group"
+	ScopePool   = "pool"
+)
+
+type Limiter struct {
+	mutex  sync.Mutex
+	global *Bucket
+	groups map[string]*Bucket
+	pools  map[string]*Bucket
+}
+
+type LimiterStats struct {
+	Global *BucketStats
+	Groups map[string]BucketStats
+	Pools  map[string]BucketStats
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		groups: make(map[string]*Bucket),
+		pools:  make(map[string]*Bucket),
+	}
+}
+
+func (l *Limiter) SetGroupDefault(groupUuid string, rate float64, burst int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if bucket, exists := l.groups[groupUuid]; exists && !bucket.implicit {
+		return
+	}
+
+	setMapBucket(l.groups, groupUuid, rate, burst)
+
+	if bucket, exists := l.groups[groupUuid]; exists {
+		bucket.implicit = true
+	}
+}
+
+func (l *Limiter) Set(scope string, key string, rate float64, burst int) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	switch scope {
+	case ScopeGlobal:
+		l.global = setBucket(l.global, rate, burst)
+	case ScopeGroup:
+		setMapBucket(l.groups, key, rate, burst)
+
+		if bucket, exists := l.groups[key]; exists {
+			bucket.implicit = false
+		}
+	case ScopePool:
+		setMapBucket(l.pools, key, rate, burst)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (l *Limiter) HasGlobalToken() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.global == nil || l.global.hasToken()
+}
+
+func (l *Limiter) Allow(task *tasks.Task) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	buckets := l.buckets(task)
+
+	for _, bucket := range buckets {
+		if !bucket.hasToken() {
+			return false
+		}
+	}
+
+	for _, bucket := range buckets {
+		bucket.take()
+	}
+
+	return true
+}
+
+func (l *Limiter) Return(task *tasks.Task) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for _, bucket := range l.buckets(task) {
+		bucket.giveBack()
+	}
+}
+
+func (l *Limiter) FlushStaleGroups(ttl time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for groupUuid, bucket := range l.groups {
+		if !bucket.implicit {
+			continue
+		}
+
+		bucket.mutex.Lock()
+		isStale := time.Since(bucket.usedAt) > ttl
+		bucket.mutex.Unlock()
+
+		if isStale {
+			delete(l.groups, groupUuid)
+		}
+	}
+}
+
+func (l *Limiter) Stats() LimiterStats {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	stats := LimiterStats{
+		Groups: make(map[string]BucketStats, len(l.groups)),
+		Pools:  make(map[string]BucketStats, len(l.pools)),
+	}
+
+	if l.global != nil {
+		globalStats := l.global.Stats()
+
+		stats.Global = &globalStats
+	}
+
+	for groupUuid, bucket := range l.groups {
+		stats.Groups[groupUuid] = bucket.Stats()
+	}
+
+	for tag, bucket := range l.pools {
+		stats.Pools[tag] = bucket.Stats()
+	}
+
+	return stats
+}
+
+func (l *Limiter) buckets(task *tasks.Task) []*Bucket {
+	var buckets []*Bucket
+
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+
+	if bucket, exists := l.groups[task.GroupUuid]; exists {
+		buckets = append(buckets, bucket)
+	}
+
+	for _, tag := range task.RequiredTags {
+		if bucket, exists := l.pools[tag]; exists {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	return buckets
+}
+
+func setBucket(bucket *Bucket, rate float64, burst int) *Bucket {
+	if rate <= 0 {
+		return nil
+	}
+
+	if bucket == nil {
+		return NewBucket(rate, burst)
+	}
+
+	bucket.Set(rate, burst)
+
+	return bucket
+}
+
+func setMapBucket(buckets map[string]*Bucket, key string, rate float64, burst int) {
+	bucket := setBucket(buckets[key], rate, burst)
+
+	if bucket == nil {
+		delete(buckets, key)
+	} else {
+		buckets[key] = bucket
+	}
+}
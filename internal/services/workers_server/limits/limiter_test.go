@@ -0,0 +1,79 @@
+package limits
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sparallel_server/internal/services/workers_server/tasks"
+	"testing"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter := NewLimiter()
+
+	limited := &tasks.Task{GroupUuid: "limited"}
+	free := &tasks.Task{GroupUuid: "free"}
+
+	assert.True(t, limiter.Set(ScopeGroup, "limited", 0.001, 2))
+	assert.False(t, limiter.Set("unknown", "", 1, 1))
+
+	assert.True(t, limiter.Allow(limited))
+	assert.True(t, limiter.Allow(limited))
+	assert.False(t, limiter.Allow(limited))
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, limiter.Allow(free))
+	}
+
+	limiter.Set(ScopeGlobal, "", 0.001, 1)
+
+	assert.True(t, limiter.Allow(free))
+	assert.False(t, limiter.Allow(free))
+	assert.False(t, limiter.HasGlobalToken())
+
+	limiter.Set(ScopeGlobal, "", 0, 0)
+
+	assert.True(t, limiter.HasGlobalToken())
+	assert.Equal(t, 1, len(limiter.Stats().Groups))
+}
+
+func TestLimiter_FlushStaleGroups(t *testing.T) {
+	limiter := NewLimiter()
+
+	limiter.Set(ScopeGroup, "explicit", 1, 1)
+	limiter.SetGroupDefault("explicit", 100, 100)
+	limiter.SetGroupDefault("implicit", 100, 100)
+
+	assert.Equal(t, float64(1), limiter.Stats().Groups["explicit"].Rate, "explicit limit takes precedence")
+
+	limiter.FlushStaleGroups(0)
+
+	groups := limiter.Stats().Groups
+
+	assert.Contains(t, groups, "explicit")
+	assert.NotContains(t, groups, "implicit")
+
+	limiter.Set(ScopeGroup, "explicit", 0, 0)
+
+	assert.Empty(t, limiter.Stats().Groups)
+}
+
+func TestLimiter_Return(t *testing.T) {
+	limiter := NewLimiter()
+
+	task := &tasks.Task{GroupUuid: "limited", RequiredTags: []string{"gpu"}}
+
+	limiter.Set(ScopeGlobal, "", 0.001, 1)
+	limiter.Set(ScopeGroup, "limited", 0.001, 1)
+	limiter.Set(ScopePool, "gpu", 0.001, 1)
+
+	assert.True(t, limiter.Allow(task))
+	assert.False(t, limiter.Allow(task))
+
+	limiter.Return(task)
+
+	assert.True(t, limiter.Allow(task), "returned tokens are taken again")
+
+	limiter.Return(task)
+	limiter.Return(task)
+
+	assert.Equal(t, float64(1), limiter.Stats().Groups["limited"].Tokens, "tokens are not returned over the burst")
+}
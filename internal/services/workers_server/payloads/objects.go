@@ -0,0 +1,18 @@
+package payloads
+
+type Encoding string
+
+const (
+	EncodingPlain Encoding = ""
+	EncodingGzip  Encoding = "gzip"
+	EncodingSpill Encoding = "spill"
+)
+
+// SpillFramePrefix marks a worker frame which carries a path to a spill file instead of the data
+const SpillFramePrefix = "sparallel-spill:"
+
+type Packer struct {
+	compressThreshold int
+	spillThreshold    int
+	spillDir          string
+}
@@ -0,0 +1,163 @@
+package payloads
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sparallel_server/pkg/foundation/errs"
+	"strings"
+)
+
+func NewPacker(compressThreshold int, spillThreshold int, spillDir string) *Packer {
+	if spillDir == "" {
+		spillDir = filepath.Join(os.TempDir(), "sparallel")
+	}
+
+	return &Packer{
+		compressThreshold: compressThreshold,
+		spillThreshold:    spillThreshold,
+		spillDir:          spillDir,
+	}
+}
+
+func (p *Packer) Pack(data string) (string, Encoding, error) {
+	if p.spillThreshold > 0 && len(data) >= p.spillThreshold {
+		path, err := p.spill(data)
+
+		if err != nil {
+			return "", EncodingPlain, errs.Err(err)
+		}
+
+		return path, EncodingSpill, nil
+	}
+
+	if p.compressThreshold > 0 && len(data) >= p.compressThreshold {
+		compressed, err := compress(data)
+
+		if err != nil {
+			return "", EncodingPlain, errs.Err(err)
+		}
+
+		return compressed, EncodingGzip, nil
+	}
+
+	return data, EncodingPlain, nil
+}
+
+// PackResponse packs a worker response. A spill frame from the worker is accepted as is
+// when it points to a file inside the spill directory.
+func (p *Packer) PackResponse(data string) (string, Encoding, error) {
+	if !strings.HasPrefix(data, SpillFramePrefix) {
+		return p.Pack(data)
+	}
+
+	path := filepath.Clean(strings.TrimPrefix(data, SpillFramePrefix))
+
+	if !strings.HasPrefix(path, filepath.Clean(p.spillDir)+string(filepath.Separator)) {
+		return "", EncodingPlain, errs.Err(errors.New("spill file [" + path + "] is out of the spill directory"))
+	}
+
+	return path, EncodingSpill, nil
+}
+
+func (p *Packer) Frame(data string, encoding Encoding) (string, error) {
+	if encoding == EncodingSpill {
+		return SpillFramePrefix + data, nil
+	}
+
+	return Unpack(data, encoding)
+}
+
+func (p *Packer) spill(data string) (string, error) {
+	if err := os.MkdirAll(p.spillDir, 0700); err != nil {
+		return "", errs.Err(err)
+	}
+
+	path := filepath.Join(p.spillDir, uuid.New().String()+".payload")
+
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		return "", errs.Err(err)
+	}
+
+	return path, nil
+}
+
+func Unpack(data string, encoding Encoding) (string, error) {
+	switch encoding {
+	case EncodingGzip:
+		return decompress(data)
+	case EncodingSpill:
+		content, err := os.ReadFile(data)
+
+		if err != nil {
+			return "", errs.Err(err)
+		}
+
+		return string(content), nil
+	default:
+		return data, nil
+	}
+}
+
+func Size(data string, encoding Encoding) int {
+	if encoding != EncodingSpill {
+		return len(data)
+	}
+
+	info, err := os.Stat(data)
+
+	if err != nil {
+		return len(data)
+	}
+
+	return int(info.Size())
+}
+
+func Release(data string, encoding Encoding) {
+	if encoding != EncodingSpill {
+		return
+	}
+
+	err := os.Remove(data)
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Error("Failed to remove spill file [" + data + "]: " + err.Error())
+	}
+}
+
+func compress(data string) (string, error) {
+	var buffer bytes.Buffer
+
+	writer := gzip.NewWriter(&buffer)
+
+	if _, err := writer.Write([]byte(data)); err != nil {
+		return "", errs.Err(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", errs.Err(err)
+	}
+
+	return buffer.String(), nil
+}
+
+func decompress(data string) (string, error) {
+	reader, err := gzip.NewReader(strings.NewReader(data))
+
+	if err != nil {
+		return "", errs.Err(err)
+	}
+
+	decompressed, err := io.ReadAll(reader)
+
+	if err != nil {
+		return "", errs.Err(err)
+	}
+
+	return string(decompressed), nil
+}
@@ -0,0 +1,61 @@
+package payloads
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPacker_Pack(t *testing.T) {
+	packer := NewPacker(10, 100, t.TempDir())
+
+	small := "small"
+	medium := strings.Repeat("m", 50)
+	large := strings.Repeat("l", 200)
+
+	data, encoding, err := packer.Pack(small)
+
+	assert.NoError(t, err)
+	assert.Equal(t, EncodingPlain, encoding)
+	assert.Equal(t, small, data)
+
+	data, encoding, err = packer.Pack(medium)
+
+	assert.NoError(t, err)
+	assert.Equal(t, EncodingGzip, encoding)
+
+	unpacked, err := Unpack(data, encoding)
+
+	assert.NoError(t, err)
+	assert.Equal(t, medium, unpacked)
+
+	data, encoding, err = packer.Pack(large)
+
+	assert.NoError(t, err)
+	assert.Equal(t, EncodingSpill, encoding)
+
+	frame, err := packer.Frame(data, encoding)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SpillFramePrefix+data, frame)
+
+	unpacked, err = Unpack(data, encoding)
+
+	assert.NoError(t, err)
+	assert.Equal(t, large, unpacked)
+
+	Release(data, encoding)
+
+	_, err = os.Stat(data)
+
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPacker_PackResponse(t *testing.T) {
+	packer := NewPacker(0, 0, t.TempDir())
+
+	_, _, err := packer.PackResponse(SpillFramePrefix + "/etc/passwd")
+
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package processes
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"sparallel_server/pkg/foundation/errs"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // TODO: binary communication
@@ -23,6 +25,7 @@ type Process struct {
 	Cmd    *exec.Cmd
 	Stdin  io.WriteCloser
 	Stdout io.ReadCloser
+	done   chan struct{}
 }
 
 type Response struct {
@@ -30,6 +33,10 @@ type Response struct {
 	Error error
 }
 
+type Handshake struct {
+	Tags []string
+}
+
 type FinishedHandler func(processUuid string, cmd *exec.Cmd)
 
 func CreateProcess(ctx context.Context, command string, handler FinishedHandler) (*Process, error) {
@@ -81,18 +88,23 @@ func CreateProcess(ctx context.Context, command string, handler FinishedHandler)
 		return nil, errs.Err(err)
 	}
 
+	process := &Process{
+		Uuid:   processUuid,
+		Cmd:    cmd,
+		Stdout: stdout,
+		Stdin:  stdin,
+		done:   make(chan struct{}),
+	}
+
 	go func(_ context.Context, cmd *exec.Cmd, handler FinishedHandler, processUuid string) {
 		_ = cmd.Wait()
 
+		close(process.done)
+
 		handler(processUuid, cmd)
 	}(ctx, cmd, handler, processUuid)
 
-	return &Process{
-		Uuid:   processUuid,
-		Cmd:    cmd,
-		Stdout: stdout,
-		Stdin:  stdin,
-	}, nil
+	return process, nil
 }
 
 func (p *Process) IsRunning() bool {
@@ -151,6 +163,51 @@ func (p *Process) Read() *Response {
 	}
 }
 
+func (p *Process) ReadHandshake(timeout time.Duration) (*Handshake, error) {
+	responses := make(chan *Response, 1)
+
+	go func() {
+		responses <- p.Read()
+	}()
+
+	select {
+	case response := <-responses:
+		if response.Error != nil {
+			return nil, errs.Err(response.Error)
+		}
+
+		handshake := &Handshake{}
+
+		err := json.Unmarshal([]byte(response.Data), handshake)
+
+		if err != nil {
+			return nil, errs.Err(errors.New("invalid handshake [" + response.Data + "]: " + err.Error()))
+		}
+
+		return handshake, nil
+	case <-time.After(timeout):
+		return nil, errs.Err(errors.New("handshake timeout of process [" + p.Uuid + "]"))
+	}
+}
+
+func (p *Process) Terminate(grace time.Duration) {
+	err := p.Cmd.Process.Signal(os.Interrupt)
+
+	if err != nil {
+		_ = p.Close()
+
+		return
+	}
+
+	select {
+	case <-p.done:
+	case <-time.After(grace):
+		slog.Warn("Process [" + p.Uuid + "] is still running after interrupt. Killing...")
+
+		_ = p.Close()
+	}
+}
+
 func (p *Process) Close() error {
 	err := p.Cmd.Process.Kill()
 
@@ -0,0 +1,66 @@
+package processes
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProcess_TerminateKillsAfterGrace(t *testing.T) {
+	cases := map[string]struct {
+		script string
+		killed bool
+	}{
+		"ignoring interrupt":   {script: "trap '' INT\nwhile true; do sleep 0.05; done\n", killed: true},
+		"exiting on interrupt": {script: "trap 'exit 0' INT\nwhile true; do sleep 0.05; done\n", killed: false},
+	}
+
+	grace := 500 * time.Millisecond
+
+	for name, testCase := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "worker.sh")
+
+			assert.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+testCase.script), 0700))
+
+			finished := make(chan struct{})
+
+			process, err := CreateProcess(context.Background(), path, func(_ string, _ *exec.Cmd) {
+				close(finished)
+			})
+
+			assert.NoError(t, err)
+
+			// the trap is set before the interrupt is sent
+			time.Sleep(100 * time.Millisecond)
+
+			startedAt := time.Now()
+
+			process.Terminate(grace)
+
+			select {
+			case <-finished:
+			case <-time.After(5 * time.Second):
+				assert.Fail(t, "process is still running")
+
+				_ = process.Close()
+
+				return
+			}
+
+			elapsed := time.Since(startedAt)
+
+			if testCase.killed {
+				assert.GreaterOrEqual(t, elapsed, grace)
+				assert.Equal(t, "signal: killed", process.Cmd.ProcessState.String())
+			} else {
+				assert.Less(t, elapsed, grace)
+				assert.True(t, process.Cmd.ProcessState.Success())
+			}
+		})
+	}
+}
@@ -5,8 +5,15 @@ import (
 	"errors"
 	"log/slog"
 	"os/exec"
+	"slices"
 	"sparallel_server/internal/config"
+	"sparallel_server/internal/services/workers_server/callbacks"
+	"sparallel_server/internal/services/workers_server/chaos"
+	"sparallel_server/internal/services/workers_server/events"
+	"sparallel_server/internal/services/workers_server/limits"
+	"sparallel_server/internal/services/workers_server/payloads"
 	"sparallel_server/internal/services/workers_server/processes"
+	"sparallel_server/internal/services/workers_server/subscriptions"
 	"sparallel_server/internal/services/workers_server/tasks"
 	"sparallel_server/internal/services/workers_server/workers"
 	appConfig "sparallel_server/pkg/foundation/config"
@@ -21,6 +28,8 @@ import (
 var service *Service
 var once sync.Once
 
+const dispatchRetryInterval = 10 * time.Millisecond
+
 // TODO: zombie hunting
 
 type Service struct {
@@ -30,11 +39,25 @@ type Service struct {
 	workersNumberScaleUp          int
 	workersNumberPercentScaleUp   int
 	workersNumberPercentScaleDown int
-
-	workers *workers.Workers
-	tasks   *tasks.Tasks
+	dispatchMode                  tasks.DispatchMode
+	workerTags                    []string
+	workerHandshake               bool
+	workerKillGrace               atomic.Int64 // nanoseconds, reloaded by the control ticker while tasks are handled
+	rateLimit                     float64
+	rateBurst                     int
+
+	workers   *workers.Workers
+	tasks     *tasks.Tasks
+	events    *events.Events
+	packer    *payloads.Packer
+	limiter   *limits.Limiter
+	callbacks *callbacks.Callbacks
+	chaos     *chaos.Chaos
+
+	subscriptions *subscriptions.Subscriptions
 
 	closing atomic.Bool
+	wakeup  chan struct{}
 
 	tickersCtx       context.Context
 	tickersCtxCancel context.CancelFunc
@@ -49,10 +72,24 @@ func NewService(
 	workersNumberScaleUp int,
 	workersNumberPercentScaleUp int,
 	workersNumberPercentScaleDown int,
+	dispatchMode string,
+	eventsLimit int,
+	eventsFilePath string,
+	packer *payloads.Packer,
+	taskCallbacks *callbacks.Callbacks,
+	faults *chaos.Chaos,
 ) *Service {
 	slog.Info("Creating workers service for [" + command + "] command...")
 
 	once.Do(func() {
+		taskEvents, err := events.NewEvents(eventsLimit, eventsFilePath)
+
+		if err != nil {
+			slog.Error("Failed to open task events file [" + eventsFilePath + "]: " + err.Error())
+
+			taskEvents, _ = events.NewEvents(eventsLimit, "")
+		}
+
 		service = &Service{
 			command:                       command,
 			minWorkersNumber:              minWorkersNumber,
@@ -60,14 +97,27 @@ func NewService(
 			workersNumberScaleUp:          workersNumberScaleUp,
 			workersNumberPercentScaleUp:   workersNumberPercentScaleUp,
 			workersNumberPercentScaleDown: workersNumberPercentScaleDown,
+			dispatchMode:                  tasks.ParseDispatchMode(dispatchMode),
+
+			workers:   workers.NewWorkers(),
+			tasks:     tasks.NewTasks(tasks.ParseDispatchMode(dispatchMode), taskEvents, packer),
+			events:    taskEvents,
+			packer:    packer,
+			limiter:   limits.NewLimiter(),
+			callbacks: taskCallbacks,
+			chaos:     faults,
 
-			workers: workers.NewWorkers(),
-			tasks:   tasks.NewTasks(),
+			subscriptions: subscriptions.NewSubscriptions(),
 
 			closing: atomic.Bool{},
+			wakeup:  make(chan struct{}, 1),
 
 			scaledDownAtUnixTime: time.Now().Unix(),
 		}
+
+		service.tasks.OnFinished(service.deliverCallback)
+		service.tasks.OnFinished(service.subscriptions.Publish)
+		service.tasks.OnWaiting(service.wake)
 	})
 
 	return service
@@ -77,6 +127,10 @@ func GetService() *Service {
 	return service
 }
 
+func (s *Service) IsClosing() bool {
+	return s.closing.Load()
+}
+
 func (s *Service) Start(ctx context.Context) {
 	slog.Info("Starting workers service...")
 
@@ -104,34 +158,82 @@ func (s *Service) Start(ctx context.Context) {
 
 	for _, ticker := range tickers {
 		go func(ctx context.Context, ticker func(ctx context.Context, s *Service)) {
-			for !s.closing.Load() {
+			for !s.closing.Load() && ctx.Err() == nil {
 				ticker(ctx, s)
 			}
 		}(s.tickersCtx, ticker)
 	}
 }
 
-func (s *Service) AddTask(
-	groupUuid string,
-	taskUuid string,
-	unixTimeout int,
-	payload string,
-) (*tasks.Task, error) {
+func (s *Service) AddTask(args *tasks.AddTaskArgs) (*tasks.Task, error) {
 	if s.closing.Load() {
-		slog.Error("Service is closing. Can't add task [" + taskUuid + "] to group [" + groupUuid + "]")
+		slog.Error("Service is closing. Can't add task [" + args.TaskUuid + "] to group [" + args.GroupUuid + "]")
 
-		return nil, errors.New("service is closing")
+		return nil, ErrClosing
 	}
 
-	slog.Debug("Adding task [" + taskUuid + "] to group [" + groupUuid + "]")
+	slog.Debug("Adding task [" + args.TaskUuid + "] to group [" + args.GroupUuid + "]")
+
+	if args.Callback != "" {
+		if err := s.callbacks.Validate(args.Callback); err != nil {
+			slog.Error("Can't add task [" + args.TaskUuid + "] to group [" + args.GroupUuid + "]: " + err.Error())
+
+			return nil, invalidArgument(err)
+		}
+	}
+
+	if args.GroupRateLimit > 0 {
+		s.limiter.SetGroupDefault(args.GroupUuid, args.GroupRateLimit, args.GroupRateBurst)
+	}
+
+	if args.WaitingTtl > 0 || args.ResultTtl > 0 {
+		if !s.tasks.SetGroupRetention(args.GroupUuid, args.WaitingTtl, args.ResultTtl) {
+			slog.Debug("TTLs of group [" + args.GroupUuid + "] are already set, the ones of task [" + args.TaskUuid + "] are ignored")
+		}
+	}
+
+	packedPayload, payloadEncoding, err := s.packer.Pack(args.Payload)
+
+	if err != nil {
+		slog.Error("Can't pack payload of task [" + args.TaskUuid + "]: " + err.Error())
+
+		return nil, errs.Err(err)
+	}
 
 	newTask := &tasks.Task{
-		GroupUuid:   groupUuid,
-		TaskUuid:    taskUuid,
-		UnixTimeout: unixTimeout,
-		Payload:     payload,
+		GroupUuid:          args.GroupUuid,
+		TaskUuid:           args.TaskUuid,
+		TenantId:           args.TenantId,
+		UnixTimeout:        args.UnixTimeout,
+		Payload:            packedPayload,
+		PayloadEncoding:    payloadEncoding,
+		DependsOn:          args.DependsOn,
+		InjectDependencies: args.InjectDependencies,
+		RequiredTags:       args.RequiredTags,
+		RoutingKey:         args.RoutingKey,
+		Callback:           args.Callback,
+	}
+
+	if len(args.DependsOn) > 0 {
+		err = s.tasks.AddDependent(newTask)
+
+		if err != nil {
+			slog.Error("Can't add task [" + args.TaskUuid + "] to group [" + args.GroupUuid + "]: " + err.Error())
+
+			var unknownDependency *tasks.UnknownDependencyError
+
+			if errors.As(err, &unknownDependency) {
+				return nil, notFound(err)
+			}
+
+			return nil, invalidArgument(err)
+		}
+
+		return newTask, nil
 	}
 
+	s.tasks.AddPending(newTask)
+
 	go s.tasks.AddWaiting(newTask)
 
 	return newTask, nil
@@ -147,6 +249,21 @@ func (s *Service) DetectAnyFinishedTask(groupUuid string) *tasks.Task {
 		}
 	}
 
+	response, err := payloads.Unpack(finishedTask.Response, finishedTask.ResponseEncoding)
+
+	payloads.Release(finishedTask.Response, finishedTask.ResponseEncoding)
+
+	if err != nil {
+		slog.Error("Can't unpack response of task [" + finishedTask.TaskUuid + "]: " + err.Error())
+
+		response = "can't unpack response: " + err.Error()
+
+		finishedTask.IsError = true
+	}
+
+	finishedTask.Response = response
+	finishedTask.ResponseEncoding = payloads.EncodingPlain
+
 	return finishedTask
 }
 
@@ -162,6 +279,58 @@ func (s *Service) CancelGroup(groupUuid string) {
 	}
 }
 
+func (s *Service) GetTaskEvents(taskUuid string) []events.Event {
+	return s.tasks.GetEvents(taskUuid)
+}
+
+func (s *Service) GetCallbackStatus(taskUuid string) *callbacks.Status {
+	return s.callbacks.GetStatus(taskUuid)
+}
+
+// Subscribe pushes the finished tasks of the groups to the subscriber, starting with the already finished ones.
+// A task may be pushed twice when it finishes during subscribing.
+func (s *Service) Subscribe(groupUuids []string) *subscriptions.Subscriber {
+	subscriber := s.subscriptions.Subscribe(groupUuids)
+
+	for _, groupUuid := range groupUuids {
+		s.subscriptions.PublishTo(subscriber, s.tasks.ListFinished(groupUuid))
+	}
+
+	return subscriber
+}
+
+func (s *Service) Unsubscribe(subscriber *subscriptions.Subscriber) {
+	s.subscriptions.Unsubscribe(subscriber)
+}
+
+func (s *Service) AcknowledgeTask(groupUuid string, taskUuid string) bool {
+	return s.tasks.Acknowledge(groupUuid, taskUuid, "subscription")
+}
+
+func (s *Service) SetChaosFault(fault string, rate float64) error {
+	if err := s.chaos.Set(chaos.Fault(fault), rate); err != nil {
+		return invalidArgument(err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetChaosStats() chaos.ChaosStats {
+	return s.chaos.Stats()
+}
+
+func (s *Service) SetRateLimit(scope string, key string, rate float64, burst int) error {
+	if !s.limiter.Set(scope, key, rate, burst) {
+		return invalidArgument(errors.New("unknown rate limit scope [" + scope + "]"))
+	}
+
+	s.wake()
+
+	slog.Warn("Rate limit of [" + scope + "] [" + key + "] set to [" + strconv.FormatFloat(rate, 'f', -1, 64) + "]")
+
+	return nil
+}
+
 func (s *Service) Reload(message string) {
 	slog.Warn("Reload workers with message [" + message + "]...")
 
@@ -182,6 +351,7 @@ func (s *Service) Stats() WorkersServerStats {
 		},
 		Tasks: StatTasks{
 			s.tasks.GetWaitingCount(),
+			s.tasks.GetHoldingCount(),
 			s.tasks.GetFinishedCount(),
 			s.tasks.GetAddedTotalCount(),
 			s.tasks.GetReAddedTotalCount(),
@@ -190,7 +360,16 @@ func (s *Service) Stats() WorkersServerStats {
 			s.tasks.GetSuccessTotalCount(),
 			s.tasks.GetErrorTotalCount(),
 			s.tasks.GetTimeoutTotalCount(),
+			s.tasks.GetEvictedTotalCount(),
+			s.tasks.GetFinishedBytes(),
+			s.tasks.GetGroupsWaitStats(),
+			s.tasks.GetLatenciesStats(),
+		},
+		Subscriptions: StatSubscriptions{
+			s.subscriptions.GetCount(),
+			s.subscriptions.GetDroppedTotalCount(),
 		},
+		RateLimits: s.limiter.Stats(),
 	}
 }
 
@@ -203,7 +382,7 @@ func (s *Service) Close() error {
 
 	s.tickersCtxCancel()
 
-	return nil
+	return s.events.Close()
 }
 
 func (s *Service) tickControlWorkers(ctx context.Context) error {
@@ -223,6 +402,33 @@ func (s *Service) tickControlWorkers(ctx context.Context) error {
 	s.workersNumberScaleUp = cfg.GetWorkersNumberScaleUp()
 	s.workersNumberPercentScaleUp = cfg.GetWorkersNumberPercentScaleUp()
 	s.workersNumberPercentScaleDown = cfg.GetWorkersNumberPercentScaleDown()
+	s.workerTags = cfg.GetWorkerTags()
+	s.workerHandshake = cfg.IsWorkerHandshake()
+	s.workerKillGrace.Store(int64(time.Duration(cfg.GetWorkerKillGraceSeconds()) * time.Second))
+
+	s.tasks.SetRetention(
+		cfg.GetWorkersWaitingTtl(),
+		cfg.GetWorkersResultTtl(),
+		cfg.GetWorkersFinishedMaxBytes(),
+	)
+
+	rateLimit, rateBurst := cfg.GetWorkersRateLimit(), cfg.GetWorkersRateBurst()
+
+	if s.rateLimit != rateLimit || s.rateBurst != rateBurst {
+		s.rateLimit, s.rateBurst = rateLimit, rateBurst
+
+		s.limiter.Set(limits.ScopeGlobal, "", rateLimit, rateBurst)
+	}
+
+	dispatchMode := tasks.ParseDispatchMode(cfg.GetWorkersDispatchMode())
+
+	if s.dispatchMode != dispatchMode {
+		slog.Warn("Dispatch mode changed to [" + string(dispatchMode) + "]")
+
+		s.dispatchMode = dispatchMode
+
+		s.tasks.SetDispatchMode(dispatchMode)
+	}
 
 	needWorkersNumber := s.minWorkersNumber
 
@@ -259,7 +465,25 @@ func (s *Service) tickControlWorkers(ctx context.Context) error {
 
 		slog.Debug("Process [" + newProcess.Uuid + "] [" + strconv.Itoa(newProcess.Cmd.Process.Pid) + "] created.")
 
-		s.workers.Add(newProcess)
+		tags := s.workerTags
+
+		if s.workerHandshake {
+			handshake, err := newProcess.ReadHandshake(5 * time.Second)
+
+			if err != nil {
+				slog.Error("Failed handshake with process [" + newProcess.Uuid + "]: " + err.Error())
+
+				_ = newProcess.Close()
+
+				break
+			}
+
+			tags = append(slices.Clone(tags), handshake.Tags...)
+		}
+
+		s.workers.Add(newProcess, tags)
+
+		s.wake()
 
 		createdCount += 1
 	}
@@ -280,40 +504,135 @@ func (s *Service) tickControlWorkers(ctx context.Context) error {
 
 func (s *Service) tickClearFinishedTasks() {
 	s.tasks.FlushRottenTasks()
+
+	s.limiter.FlushStaleGroups(10 * time.Minute)
+
+	s.callbacks.FlushStale(time.Hour)
+}
+
+// deliverCallback enqueues the result of the finished task for delivery to its callback.
+// The result stays collectable until the delivery succeeds.
+func (s *Service) deliverCallback(task *tasks.Task) {
+	if task.Callback == "" {
+		return
+	}
+
+	delivery := &callbacks.Delivery{
+		Target:           task.Callback,
+		GroupUuid:        task.GroupUuid,
+		TaskUuid:         task.TaskUuid,
+		IsError:          task.IsError,
+		IsTimeout:        task.TimedOut,
+		Response:         task.Response,
+		ResponseEncoding: task.ResponseEncoding,
+	}
+
+	s.callbacks.Enqueue(delivery, func(err error) {
+		if err != nil {
+			s.tasks.AddEvent(task, events.TypeUndelivered, 0, err.Error())
+
+			return
+		}
+
+		s.tasks.Acknowledge(task.GroupUuid, task.TaskUuid, "callback")
+	})
 }
 
 func (s *Service) tickHandleTasks(ctx context.Context) {
+	for s.dispatchTask() {
+	}
+
+	var retry <-chan time.Time
+
+	if s.workers.GetFreeCount() > 0 && s.tasks.GetWaitingCount() > 0 {
+		// rate limits or required tags hold the tasks back
+		timer := time.NewTimer(dispatchRetryInterval)
+
+		defer timer.Stop()
+
+		retry = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-s.wakeup:
+	case <-retry:
+	}
+}
+
+func (s *Service) dispatchTask() bool {
 	if s.workers.GetFreeCount() == 0 {
-		return
+		return false
 	}
 
-	task := s.tasks.TakeWaiting()
+	if !s.limiter.HasGlobalToken() {
+		return false
+	}
+
+	var worker *workers.Worker
+
+	// the task is popped only with its worker, so a task without a matching worker keeps its place
+	task := s.tasks.TakeWaiting(func(task *tasks.Task) bool {
+		if !s.workers.HasFree(task) || !s.limiter.Allow(task) {
+			return false
+		}
+
+		worker = s.workers.Take(task)
+
+		if worker == nil {
+			// the worker was taken meanwhile, the tokens are not spent
+			s.limiter.Return(task)
+
+			return false
+		}
+
+		return true
+	})
 
 	if task == nil {
-		return
+		return false
 	}
 
-	go func(_ context.Context, task *tasks.Task) {
-		s.handleTask(task)
-	}(ctx, task)
+	go s.handleTask(task, worker)
+
+	return true
+}
+
+func (s *Service) wake() {
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
 }
 
-func (s *Service) handleTask(task *tasks.Task) {
+func (s *Service) handleTask(task *tasks.Task, worker *workers.Worker) {
 	slog.Debug("Handling task [" + task.TaskUuid + "]")
 
-	worker := s.workers.Take(task)
+	process := worker.GetProcess()
 
-	if worker == nil {
-		slog.Debug("Not found worker for task [" + task.TaskUuid + "]")
+	frame, err := s.packer.Frame(task.Payload, task.PayloadEncoding)
 
-		s.tasks.ReAddWaiting(task)
+	if err != nil {
+		slog.Error("Can't unpack payload of task [" + task.TaskUuid + "]: " + err.Error())
+
+		task.IsFinished = true
+		task.Response = "can't unpack payload: " + err.Error()
+		task.IsError = true
+
+		s.workers.Free(worker)
+		s.wake()
+		s.tasks.AddFinished(task)
 
 		return
 	}
 
-	process := worker.GetProcess()
+	s.chaos.DelayDispatch()
 
-	err := process.Write(task.Payload)
+	if s.chaos.Roll(chaos.FaultFailWrite) {
+		err = errors.New("chaos: write failed")
+	} else {
+		err = process.Write(frame)
+	}
 
 	if err != nil {
 		slog.Error("Error start task [" + task.TaskUuid + "]. Re waiting.")
@@ -327,50 +646,94 @@ func (s *Service) handleTask(task *tasks.Task) {
 		return
 	}
 
-	for {
-		if task.IsTimeout() {
-			task.IsFinished = true
-			task.Response = "timeout"
-			task.IsError = true
+	s.tasks.Dispatched(task)
 
-			s.tasks.AddFinished(task)
+	pid := process.Cmd.Process.Pid
 
-			s.workers.Free(worker)
+	s.tasks.AddEvent(task, events.TypeDispatched, pid, "")
 
-			break
-		}
+	if s.chaos.Roll(chaos.FaultKillWorker) {
+		_ = process.Cmd.Process.Kill()
+	}
 
-		response := process.Read()
+	responses := make(chan *processes.Response, 1)
 
-		if response == nil {
-			continue
-		}
+	go func(process *processes.Process) {
+		responses <- process.Read()
+	}(process)
 
-		if response.Error != nil {
-			s.workers.DeleteByProcess(process.Uuid)
+	untilDeadline := time.Until(task.Deadline())
 
-			_ = process.Close()
+	if s.chaos.Roll(chaos.FaultForceTimeout) {
+		untilDeadline = 0
+	}
 
-			responseError := strings.TrimSpace(response.Error.Error())
+	deadline := time.NewTimer(untilDeadline)
 
-			task.IsFinished = true
-			task.Response = responseError
-			task.IsError = true
+	defer deadline.Stop()
 
-			slog.Error("Error task [" + task.TaskUuid + "] response: " + responseError)
+	var response *processes.Response
 
-			s.tasks.AddFinished(task)
+	select {
+	case response = <-responses:
+	case <-deadline.C:
+		slog.Warn("Task [" + task.TaskUuid + "] deadline exceeded. Terminating process [" + strconv.Itoa(pid) + "]")
 
-			break
-		}
+		s.workers.DeleteByProcess(process.Uuid)
+
+		grace := time.Duration(s.workerKillGrace.Load())
+
+		go process.Terminate(grace)
 
 		task.IsFinished = true
-		task.Response = response.Data
-		task.IsError = false
+		task.Response = "timeout"
+		task.IsError = true
+		task.TimedOut = true
+
+		s.tasks.AddEvent(task, events.TypeTimeout, pid, "")
+
+		s.tasks.AddFinished(task)
+
+		return
+	}
+
+	if response.Error != nil {
+		s.workers.DeleteByProcess(process.Uuid)
+
+		_ = process.Close()
+
+		responseError := strings.TrimSpace(response.Error.Error())
+
+		task.IsFinished = true
+		task.Response = responseError
+		task.IsError = true
+
+		slog.Error("Error task [" + task.TaskUuid + "] response: " + responseError)
+
+		s.tasks.AddEvent(task, events.TypeError, pid, responseError)
 
-		s.workers.Free(worker)
 		s.tasks.AddFinished(task)
 
-		break
+		return
 	}
+
+	data, encoding, err := s.packer.PackResponse(s.chaos.MangleResponse(response.Data))
+
+	if err != nil {
+		slog.Error("Can't pack response of task [" + task.TaskUuid + "]: " + err.Error())
+
+		data = "can't pack response: " + err.Error()
+		encoding = payloads.EncodingPlain
+	}
+
+	task.IsFinished = true
+	task.Response = data
+	task.ResponseEncoding = encoding
+	task.IsError = err != nil
+
+	s.tasks.AddEvent(task, events.TypeResponse, pid, "")
+
+	s.workers.Free(worker)
+	s.wake()
+	s.tasks.AddFinished(task)
 }
@@ -0,0 +1,93 @@
+package workers_server
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sparallel_server/internal/services/workers_server/events"
+	"sparallel_server/internal/services/workers_server/limits"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sparallel_server/internal/services/workers_server/processes"
+	"sparallel_server/internal/services/workers_server/tasks"
+	"sparallel_server/internal/services/workers_server/workers"
+	"testing"
+	"time"
+)
+
+func TestService_DispatchKeepsTasksWithoutMatchingWorker(t *testing.T) {
+	taskEvents, err := events.NewEvents(100, "")
+
+	assert.NoError(t, err)
+
+	service := &Service{
+		workers: workers.NewWorkers(),
+		tasks:   tasks.NewTasks(tasks.DispatchModeFifo, taskEvents, payloads.NewPacker(0, 0, t.TempDir())),
+		events:  taskEvents,
+		limiter: limits.NewLimiter(),
+	}
+
+	service.limiter.Set(limits.ScopeGlobal, "", 1, 1)
+
+	service.workers.Add(&processes.Process{Uuid: "plain"}, nil)
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	for _, taskUuid := range []string{"1", "2", "3"} {
+		service.tasks.AddWaiting(&tasks.Task{
+			GroupUuid:    "g",
+			TaskUuid:     taskUuid,
+			UnixTimeout:  unixTimeout,
+			RequiredTags: []string{"gpu"},
+		})
+	}
+
+	for i := 0; i < 10; i++ {
+		assert.False(t, service.dispatchTask())
+	}
+
+	assert.True(t, service.limiter.HasGlobalToken(), "no token is taken without a worker")
+
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Equal(t, 0, service.tasks.GetReAddedTotalCount())
+	assert.Equal(t, 0, service.tasks.GetTookTotalCount())
+	assert.Equal(t, 3, service.tasks.GetWaitingCount())
+	assert.Equal(t, 1, service.workers.GetFreeCount())
+
+	for _, event := range service.tasks.GetEvents("1") {
+		assert.NotEqual(t, events.TypeReAdded, event.Type)
+	}
+
+	for _, taskUuid := range []string{"1", "2", "3"} {
+		assert.Equal(t, taskUuid, service.tasks.TakeWaiting(nil).TaskUuid)
+	}
+}
+
+func TestService_AddTaskKnowsDependencyAtOnce(t *testing.T) {
+	taskEvents, err := events.NewEvents(100, "")
+
+	assert.NoError(t, err)
+
+	packer := payloads.NewPacker(0, 0, t.TempDir())
+
+	service := &Service{
+		workers: workers.NewWorkers(),
+		tasks:   tasks.NewTasks(tasks.DispatchModeFifo, taskEvents, packer),
+		events:  taskEvents,
+		packer:  packer,
+		limiter: limits.NewLimiter(),
+	}
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	// the dependency is added to the waiting tasks asynchronously
+	_, err = service.AddTask(&tasks.AddTaskArgs{GroupUuid: "g", TaskUuid: "1", UnixTimeout: unixTimeout})
+
+	assert.NoError(t, err)
+
+	_, err = service.AddTask(&tasks.AddTaskArgs{GroupUuid: "g", TaskUuid: "2", UnixTimeout: unixTimeout, DependsOn: []string{"1"}})
+
+	assert.NoError(t, err)
+
+	_, err = service.AddTask(&tasks.AddTaskArgs{GroupUuid: "g", TaskUuid: "3", UnixTimeout: unixTimeout, DependsOn: []string{"0"}})
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -1,8 +1,15 @@
 package workers_server
 
+import (
+	"sparallel_server/internal/services/workers_server/limits"
+	"sparallel_server/internal/services/workers_server/tasks"
+)
+
 type WorkersServerStats struct {
-	Workers StatWorkers
-	Tasks   StatTasks
+	Workers       StatWorkers
+	Tasks         StatTasks
+	Subscriptions StatSubscriptions
+	RateLimits    limits.LimiterStats
 }
 
 type StatWorkers struct {
@@ -16,8 +23,14 @@ type StatWorkers struct {
 	DeletedCount int
 }
 
+type StatSubscriptions struct {
+	Count             int
+	DroppedTotalCount int
+}
+
 type StatTasks struct {
 	WaitingCount       int
+	HoldingCount       int
 	FinishedCount      int
 	AddedTotalCount    int
 	ReAddedTotalCount  int
@@ -26,4 +39,8 @@ type StatTasks struct {
 	SuccessTotalCount  int
 	ErrorTotalCount    int
 	TimeoutTotalCount  int
+	EvictedTotalCount  int
+	FinishedBytes      int
+	GroupsWait         map[string]tasks.GroupWaitStats
+	Latencies          tasks.LatenciesStats
 }
@@ -0,0 +1,33 @@
+package subscriptions
+
+import (
+	"slices"
+	"sparallel_server/internal/services/workers_server/tasks"
+	"sync"
+	"sync/atomic"
+)
+
+const subscriberBufferSize = 1024
+
+type Subscriptions struct {
+	mutex  sync.Mutex
+	groups map[string]map[*Subscriber]bool
+
+	droppedTotalCount atomic.Int64
+}
+
+type Subscriber struct {
+	groupUuids []string
+	tasks      chan *tasks.Task
+	closed     bool
+}
+
+// Tasks returns the channel of the finished tasks of the subscribed groups.
+// It is closed after unsubscribing.
+func (s *Subscriber) Tasks() <-chan *tasks.Task {
+	return s.tasks
+}
+
+func (s *Subscriber) IsSubscribed(groupUuid string) bool {
+	return slices.Contains(s.groupUuids, groupUuid)
+}
@@ -0,0 +1,112 @@
+package subscriptions
+
+import (
+	"log/slog"
+	"sparallel_server/internal/services/workers_server/tasks"
+)
+
+func NewSubscriptions() *Subscriptions {
+	return &Subscriptions{
+		groups: make(map[string]map[*Subscriber]bool),
+	}
+}
+
+func (s *Subscriptions) Subscribe(groupUuids []string) *Subscriber {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscriber := &Subscriber{
+		groupUuids: groupUuids,
+		tasks:      make(chan *tasks.Task, subscriberBufferSize),
+	}
+
+	for _, groupUuid := range groupUuids {
+		subscribers, exists := s.groups[groupUuid]
+
+		if !exists {
+			subscribers = make(map[*Subscriber]bool)
+
+			s.groups[groupUuid] = subscribers
+		}
+
+		subscribers[subscriber] = true
+	}
+
+	return subscriber
+}
+
+func (s *Subscriptions) Unsubscribe(subscriber *Subscriber) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if subscriber.closed {
+		return
+	}
+
+	for _, groupUuid := range subscriber.groupUuids {
+		subscribers := s.groups[groupUuid]
+
+		delete(subscribers, subscriber)
+
+		if len(subscribers) == 0 {
+			delete(s.groups, groupUuid)
+		}
+	}
+
+	subscriber.closed = true
+
+	close(subscriber.tasks)
+}
+
+// Publish pushes the finished task to the subscribers of its group.
+// A subscriber with a full buffer misses the task, it stays collectable by polling.
+func (s *Subscriptions) Publish(task *tasks.Task) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for subscriber := range s.groups[task.GroupUuid] {
+		s.push(subscriber, task)
+	}
+}
+
+func (s *Subscriptions) PublishTo(subscriber *Subscriber, finishedTasks []*tasks.Task) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if subscriber.closed {
+		return
+	}
+
+	for _, task := range finishedTasks {
+		s.push(subscriber, task)
+	}
+}
+
+func (s *Subscriptions) GetCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	unique := make(map[*Subscriber]bool)
+
+	for _, subscribers := range s.groups {
+		for subscriber := range subscribers {
+			unique[subscriber] = true
+		}
+	}
+
+	return len(unique)
+}
+
+func (s *Subscriptions) GetDroppedTotalCount() int {
+	return int(s.droppedTotalCount.Load())
+}
+
+func (s *Subscriptions) push(subscriber *Subscriber, task *tasks.Task) {
+	select {
+	case subscriber.tasks <- task:
+	default:
+		s.droppedTotalCount.Add(1)
+
+		slog.Warn("Subscriber buffer is full, task [" + task.TaskUuid + "] is left for polling")
+	}
+}
@@ -0,0 +1,49 @@
+package subscriptions
+
+import (
+	"sparallel_server/internal/services/workers_server/tasks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish(t *testing.T) {
+	subscriptions := NewSubscriptions()
+
+	first := subscriptions.Subscribe([]string{"a", "b"})
+	second := subscriptions.Subscribe([]string{"b"})
+
+	subscriptions.Publish(&tasks.Task{GroupUuid: "a", TaskUuid: "a1"})
+	subscriptions.Publish(&tasks.Task{GroupUuid: "b", TaskUuid: "b1"})
+	subscriptions.Publish(&tasks.Task{GroupUuid: "c", TaskUuid: "c1"})
+
+	assert.Equal(t, "a1", (<-first.Tasks()).TaskUuid)
+	assert.Equal(t, "b1", (<-first.Tasks()).TaskUuid)
+	assert.Equal(t, "b1", (<-second.Tasks()).TaskUuid)
+	assert.Len(t, second.Tasks(), 0)
+
+	assert.Equal(t, 2, subscriptions.GetCount())
+
+	subscriptions.Unsubscribe(first)
+	subscriptions.Unsubscribe(first)
+
+	_, open := <-first.Tasks()
+
+	assert.False(t, open)
+	assert.Equal(t, 1, subscriptions.GetCount())
+}
+
+func TestPublish_CountsDropped(t *testing.T) {
+	subscriptions := NewSubscriptions()
+
+	subscriber := subscriptions.Subscribe([]string{"a"})
+
+	for i := 0; i < subscriberBufferSize+2; i++ {
+		subscriptions.Publish(&tasks.Task{GroupUuid: "a"})
+	}
+
+	assert.Len(t, subscriber.Tasks(), subscriberBufferSize)
+	assert.Equal(t, 2, subscriptions.GetDroppedTotalCount())
+	assert.True(t, subscriber.IsSubscribed("a"))
+	assert.False(t, subscriber.IsSubscribed("b"))
+}
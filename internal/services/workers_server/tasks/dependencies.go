@@ -0,0 +1,536 @@
+package tasks
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sync"
+)
+
+type Dependencies struct {
+	shards   [shardsCount]*dependenciesShard
+	finished *ShardedSubTasks
+	packer   *payloads.Packer
+}
+
+type dependenciesShard struct {
+	mutex   sync.Mutex
+	pending map[string]map[string]bool
+	groups  map[string]*dependencyGroup
+}
+
+type dependencyGroup struct {
+	unixTimeout int
+	finished    map[string]bool
+	results     map[string]*dependencyResult
+	holding     map[string]*Task
+}
+
+type dependencyResult struct {
+	response string
+	err      error
+	finished bool
+	awaiting int
+}
+
+type DependenciesResolution struct {
+	Ready  []*Task
+	Failed []*Task
+}
+
+// UnknownDependencyError is returned for a dependency which is neither submitted nor finished in the group
+type UnknownDependencyError struct {
+	TaskUuid       string
+	DependencyUuid string
+}
+
+func (e *UnknownDependencyError) Error() string {
+	return "task [" + e.TaskUuid + "] depends on unknown task [" + e.DependencyUuid + "]"
+}
+
+type injectedPayload struct {
+	Payload      string
+	Dependencies map[string]string
+}
+
+func NewDependencies(finished *ShardedSubTasks, packer *payloads.Packer) *Dependencies {
+	dependencies := &Dependencies{
+		finished: finished,
+		packer:   packer,
+	}
+
+	for i := range dependencies.shards {
+		dependencies.shards[i] = &dependenciesShard{
+			pending: make(map[string]map[string]bool),
+			groups:  make(map[string]*dependencyGroup),
+		}
+	}
+
+	return dependencies
+}
+
+func (d *Dependencies) AddPending(task *Task) {
+	shard := d.shard(task.GroupUuid)
+
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	shard.addPending(task)
+}
+
+func (d *Dependencies) Forget(tasks []*Task) {
+	for _, task := range tasks {
+		shard := d.shard(task.GroupUuid)
+
+		shard.mutex.Lock()
+		shard.removePending(task)
+		shard.mutex.Unlock()
+	}
+}
+
+func (d *Dependencies) Hold(task *Task) (*DependenciesResolution, error) {
+	shard := d.shard(task.GroupUuid)
+
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	for _, dependencyUuid := range task.DependsOn {
+		if dependencyUuid == task.TaskUuid {
+			return nil, errors.New("task [" + task.TaskUuid + "] depends on itself")
+		}
+	}
+
+	group, exists := shard.groups[task.GroupUuid]
+
+	if !exists {
+		group = newDependencyGroup(task)
+	}
+
+	if group.hasCycle(task.TaskUuid, task.DependsOn, make(map[string]bool)) {
+		return nil, errors.New("task [" + task.TaskUuid + "] makes a dependency cycle")
+	}
+
+	var finishedDependencies []*Task
+
+	for _, dependencyUuid := range task.DependsOn {
+		if _, known := group.finished[dependencyUuid]; known || shard.pending[task.GroupUuid][dependencyUuid] {
+			continue
+		}
+
+		dependency := d.finished.GetByUuid(task.GroupUuid, dependencyUuid)
+
+		if dependency == nil {
+			return nil, &UnknownDependencyError{TaskUuid: task.TaskUuid, DependencyUuid: dependencyUuid}
+		}
+
+		finishedDependencies = append(finishedDependencies, dependency)
+	}
+
+	if !exists {
+		shard.groups[task.GroupUuid] = group
+	} else if task.UnixTimeout > group.unixTimeout {
+		group.unixTimeout = task.UnixTimeout
+	}
+
+	for _, dependency := range finishedDependencies {
+		group.finish(dependency, task.InjectDependencies)
+	}
+
+	shard.addPending(task)
+
+	resolution := &DependenciesResolution{}
+
+	state := group.check(task)
+
+	if state != dependenciesFailed && task.InjectDependencies {
+		if dependencyUuid := group.missingResult(task); dependencyUuid != "" {
+			// the result was not kept, because nothing awaited it when the dependency finished
+			failDependent(task, "dependency ["+dependencyUuid+"] result is not available anymore")
+
+			state = dependenciesFailed
+		}
+	}
+
+	switch state {
+	case dependenciesSucceeded:
+		group.await(task)
+		d.release(group, task, resolution)
+	case dependenciesFailed:
+		group.await(task)
+		group.forget(task)
+
+		resolution.Failed = append(resolution.Failed, task)
+	default:
+		group.await(task)
+
+		group.holding[task.TaskUuid] = task
+	}
+
+	shard.dropIdle(task.GroupUuid)
+
+	return resolution, nil
+}
+
+func (d *Dependencies) Resolve(task *Task) *DependenciesResolution {
+	shard := d.shard(task.GroupUuid)
+
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	shard.removePending(task)
+
+	resolution := &DependenciesResolution{}
+
+	group, exists := shard.groups[task.GroupUuid]
+
+	if exists {
+		// the response is unpacked before the task becomes collectable, its spill file is removed by collecting
+		group.finish(task, false)
+
+		for taskUuid, holdingTask := range group.holding {
+			switch group.check(holdingTask) {
+			case dependenciesSucceeded:
+				delete(group.holding, taskUuid)
+
+				d.release(group, holdingTask, resolution)
+			case dependenciesFailed:
+				delete(group.holding, taskUuid)
+
+				group.forget(holdingTask)
+
+				resolution.Failed = append(resolution.Failed, holdingTask)
+			}
+		}
+
+		shard.dropIdle(task.GroupUuid)
+	}
+
+	// stored under the lock, so a dependent added meanwhile sees the task either pending or finished
+	d.finished.AddTask(task)
+
+	return resolution
+}
+
+func (d *Dependencies) DeleteGroup(groupUuid string) []*Task {
+	shard := d.shard(groupUuid)
+
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	delete(shard.pending, groupUuid)
+
+	group, exists := shard.groups[groupUuid]
+
+	if !exists {
+		return nil
+	}
+
+	delete(shard.groups, groupUuid)
+
+	return group.listHolding()
+}
+
+func (d *Dependencies) FlushRotten() []*Task {
+	var flushed []*Task
+
+	for _, shard := range d.shards {
+		shard.mutex.Lock()
+
+		for groupUuid, group := range shard.groups {
+			if !isTimeout(group.unixTimeout, 5) {
+				continue
+			}
+
+			holding := group.listHolding()
+
+			for _, task := range holding {
+				shard.removePending(task)
+			}
+
+			flushed = append(flushed, holding...)
+
+			delete(shard.groups, groupUuid)
+		}
+
+		shard.mutex.Unlock()
+	}
+
+	return flushed
+}
+
+func (d *Dependencies) GetHoldingCount() int {
+	var count int
+
+	for _, shard := range d.shards {
+		shard.mutex.Lock()
+
+		for _, group := range shard.groups {
+			count += len(group.holding)
+		}
+
+		shard.mutex.Unlock()
+	}
+
+	return count
+}
+
+func (d *Dependencies) shard(groupUuid string) *dependenciesShard {
+	return d.shards[shardIndex(groupUuid)]
+}
+
+func (d *Dependencies) release(group *dependencyGroup, task *Task, resolution *DependenciesResolution) {
+	err := d.inject(group, task)
+
+	group.forget(task)
+
+	if err != nil {
+		slog.Error("Can't inject dependencies into task [" + task.TaskUuid + "]: " + err.Error())
+
+		failDependent(task, err.Error())
+
+		resolution.Failed = append(resolution.Failed, task)
+
+		return
+	}
+
+	resolution.Ready = append(resolution.Ready, task)
+}
+
+func (d *Dependencies) inject(group *dependencyGroup, task *Task) error {
+	if !task.InjectDependencies {
+		return nil
+	}
+
+	payload, err := payloads.Unpack(task.Payload, task.PayloadEncoding)
+
+	if err != nil {
+		return errors.New("payload can't be unpacked: " + err.Error())
+	}
+
+	injected := injectedPayload{
+		Payload:      payload,
+		Dependencies: make(map[string]string, len(task.DependsOn)),
+	}
+
+	for _, dependencyUuid := range task.DependsOn {
+		result, exists := group.results[dependencyUuid]
+
+		if !exists || !result.finished {
+			return errors.New("dependency [" + dependencyUuid + "] result is not available")
+		}
+
+		if result.err != nil {
+			return errors.New("dependency [" + dependencyUuid + "] result can't be unpacked: " + result.err.Error())
+		}
+
+		injected.Dependencies[dependencyUuid] = result.response
+	}
+
+	data, err := json.Marshal(injected)
+
+	if err != nil {
+		return err
+	}
+
+	packed, encoding, err := d.packer.Pack(string(data))
+
+	if err != nil {
+		return errors.New("payload can't be packed: " + err.Error())
+	}
+
+	payloads.Release(task.Payload, task.PayloadEncoding)
+
+	task.Payload = packed
+	task.PayloadEncoding = encoding
+
+	return nil
+}
+
+func (s *dependenciesShard) addPending(task *Task) {
+	group, exists := s.pending[task.GroupUuid]
+
+	if !exists {
+		group = make(map[string]bool)
+
+		s.pending[task.GroupUuid] = group
+	}
+
+	group[task.TaskUuid] = true
+}
+
+func (s *dependenciesShard) removePending(task *Task) {
+	group, exists := s.pending[task.GroupUuid]
+
+	if !exists {
+		return
+	}
+
+	delete(group, task.TaskUuid)
+
+	if len(group) == 0 {
+		delete(s.pending, task.GroupUuid)
+	}
+}
+
+func (s *dependenciesShard) dropIdle(groupUuid string) {
+	if group, exists := s.groups[groupUuid]; exists && len(group.holding) == 0 {
+		delete(s.groups, groupUuid)
+	}
+}
+
+func newDependencyGroup(task *Task) *dependencyGroup {
+	return &dependencyGroup{
+		unixTimeout: task.UnixTimeout,
+		finished:    make(map[string]bool),
+		results:     make(map[string]*dependencyResult),
+		holding:     make(map[string]*Task),
+	}
+}
+
+type dependenciesState int
+
+const (
+	dependenciesPending dependenciesState = iota
+	dependenciesSucceeded
+	dependenciesFailed
+)
+
+func (g *dependencyGroup) finish(task *Task, keep bool) {
+	g.finished[task.TaskUuid] = !task.IsError
+
+	if task.IsError {
+		return
+	}
+
+	result, awaited := g.results[task.TaskUuid]
+
+	if !awaited {
+		if !keep {
+			return
+		}
+
+		result = &dependencyResult{}
+
+		g.results[task.TaskUuid] = result
+	}
+
+	result.response, result.err = payloads.Unpack(task.Response, task.ResponseEncoding)
+	result.finished = true
+}
+
+func (g *dependencyGroup) check(task *Task) dependenciesState {
+	state := dependenciesSucceeded
+
+	for _, dependencyUuid := range task.DependsOn {
+		succeeded, exists := g.finished[dependencyUuid]
+
+		if !exists {
+			state = dependenciesPending
+
+			continue
+		}
+
+		if !succeeded {
+			failDependent(task, "dependency ["+dependencyUuid+"] failed")
+
+			return dependenciesFailed
+		}
+	}
+
+	return state
+}
+
+func (g *dependencyGroup) missingResult(task *Task) string {
+	for _, dependencyUuid := range task.DependsOn {
+		if !g.finished[dependencyUuid] {
+			continue
+		}
+
+		if result, exists := g.results[dependencyUuid]; !exists || !result.finished {
+			return dependencyUuid
+		}
+	}
+
+	return ""
+}
+
+func (g *dependencyGroup) await(task *Task) {
+	if !task.InjectDependencies {
+		return
+	}
+
+	for _, dependencyUuid := range task.DependsOn {
+		result, exists := g.results[dependencyUuid]
+
+		if !exists {
+			result = &dependencyResult{}
+
+			g.results[dependencyUuid] = result
+		}
+
+		result.awaiting++
+	}
+}
+
+func (g *dependencyGroup) forget(task *Task) {
+	if !task.InjectDependencies {
+		return
+	}
+
+	for _, dependencyUuid := range task.DependsOn {
+		result, exists := g.results[dependencyUuid]
+
+		if !exists {
+			continue
+		}
+
+		result.awaiting--
+
+		if result.awaiting <= 0 {
+			delete(g.results, dependencyUuid)
+		}
+	}
+}
+
+func (g *dependencyGroup) hasCycle(taskUuid string, dependsOn []string, visited map[string]bool) bool {
+	for _, dependencyUuid := range dependsOn {
+		if dependencyUuid == taskUuid {
+			return true
+		}
+
+		if visited[dependencyUuid] {
+			continue
+		}
+
+		visited[dependencyUuid] = true
+
+		holdingTask, exists := g.holding[dependencyUuid]
+
+		if !exists {
+			continue
+		}
+
+		if g.hasCycle(taskUuid, holdingTask.DependsOn, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *dependencyGroup) listHolding() []*Task {
+	tasks := make([]*Task, 0, len(g.holding))
+
+	for _, task := range g.holding {
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+func failDependent(task *Task, reason string) {
+	task.IsFinished = true
+	task.IsError = true
+	task.Response = reason
+}
@@ -0,0 +1,213 @@
+package tasks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"testing"
+	"time"
+)
+
+func TestDependencies_Hold(t *testing.T) {
+	dependencies := NewDependencies(NewShardedSubTasks(neverExpiry), payloads.NewPacker(0, 0, t.TempDir()))
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	first := &Task{GroupUuid: "g", TaskUuid: "1", UnixTimeout: unixTimeout, DependsOn: []string{"2"}}
+	second := &Task{GroupUuid: "g", TaskUuid: "2", UnixTimeout: unixTimeout, DependsOn: []string{"1"}}
+	self := &Task{GroupUuid: "g", TaskUuid: "3", UnixTimeout: unixTimeout, DependsOn: []string{"3"}}
+
+	dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: "2"})
+
+	resolution, err := dependencies.Hold(first)
+
+	assert.NoError(t, err)
+	assert.Empty(t, resolution.Ready)
+	assert.Empty(t, resolution.Failed)
+
+	_, err = dependencies.Hold(second)
+
+	assert.Error(t, err)
+
+	_, err = dependencies.Hold(self)
+
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, dependencies.GetHoldingCount())
+}
+
+func TestDependencies_HoldRejectsUnknown(t *testing.T) {
+	dependencies := NewDependencies(NewShardedSubTasks(neverExpiry), payloads.NewPacker(0, 0, t.TempDir()))
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: "1"})
+	dependencies.AddPending(&Task{GroupUuid: "other", TaskUuid: "2"})
+
+	_, err := dependencies.Hold(&Task{GroupUuid: "g", TaskUuid: "d", UnixTimeout: unixTimeout, DependsOn: []string{"1", "2"}})
+
+	assert.EqualError(t, err, "task [d] depends on unknown task [2]")
+	assert.Equal(t, 0, dependencies.GetHoldingCount())
+
+	flushed := &Task{GroupUuid: "g", TaskUuid: "3"}
+
+	dependencies.AddPending(flushed)
+	dependencies.Forget([]*Task{flushed})
+
+	_, err = dependencies.Hold(&Task{GroupUuid: "g", TaskUuid: "d", UnixTimeout: unixTimeout, DependsOn: []string{"3"}})
+
+	assert.EqualError(t, err, "task [d] depends on unknown task [3]")
+}
+
+func TestDependencies_Resolve(t *testing.T) {
+	finished := NewShardedSubTasks(neverExpiry)
+	dependencies := NewDependencies(finished, payloads.NewPacker(0, 0, t.TempDir()))
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	succeeding := &Task{
+		GroupUuid:          "g",
+		TaskUuid:           "s",
+		UnixTimeout:        unixTimeout,
+		Payload:            "payload",
+		DependsOn:          []string{"1"},
+		InjectDependencies: true,
+	}
+	failing := &Task{GroupUuid: "g", TaskUuid: "f", UnixTimeout: unixTimeout, DependsOn: []string{"1", "2"}}
+
+	dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: "1"})
+	dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: "2"})
+
+	_, err := dependencies.Hold(succeeding)
+	assert.NoError(t, err)
+
+	_, err = dependencies.Hold(failing)
+	assert.NoError(t, err)
+
+	resolution := dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "1", IsFinished: true, Response: "one"})
+
+	assert.Equal(t, []*Task{succeeding}, resolution.Ready)
+	assert.Empty(t, resolution.Failed)
+	assert.JSONEq(t, `{"Payload":"payload","Dependencies":{"1":"one"}}`, succeeding.Payload)
+	assert.NotNil(t, finished.GetByUuid("g", "1"), "resolved tasks are stored as finished")
+
+	resolution = dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "2", IsFinished: true, IsError: true})
+
+	assert.Empty(t, resolution.Ready)
+	assert.Equal(t, []*Task{failing}, resolution.Failed)
+	assert.True(t, failing.IsError)
+	assert.Equal(t, 0, dependencies.GetHoldingCount())
+}
+
+func TestDependencies_ResolveWithoutDependents(t *testing.T) {
+	dependencies := NewDependencies(NewShardedSubTasks(neverExpiry), payloads.NewPacker(0, 0, t.TempDir()))
+
+	task := &Task{GroupUuid: "g", TaskUuid: "1"}
+
+	dependencies.AddPending(task)
+
+	resolution := dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "1", IsFinished: true, Response: "one"})
+
+	assert.Empty(t, resolution.Ready)
+	assert.Empty(t, resolution.Failed)
+
+	shard := dependencies.shard("g")
+
+	assert.Empty(t, shard.groups, "groups without dependents are not tracked")
+	assert.Empty(t, shard.pending)
+}
+
+func TestDependencies_KeepsAwaitedResults(t *testing.T) {
+	finished := NewShardedSubTasks(neverExpiry)
+	dependencies := NewDependencies(finished, payloads.NewPacker(0, 0, t.TempDir()))
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	packer := payloads.NewPacker(0, 1, t.TempDir())
+
+	spilled, encoding, err := packer.Pack("one")
+
+	assert.NoError(t, err)
+	assert.Equal(t, payloads.EncodingSpill, encoding)
+
+	dependent := &Task{
+		GroupUuid:          "g",
+		TaskUuid:           "d",
+		UnixTimeout:        unixTimeout,
+		DependsOn:          []string{"1", "2"},
+		InjectDependencies: true,
+	}
+
+	for _, taskUuid := range []string{"1", "2", "3"} {
+		dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: taskUuid})
+	}
+
+	_, err = dependencies.Hold(dependent)
+	assert.NoError(t, err)
+
+	resolution := dependencies.Resolve(
+		&Task{GroupUuid: "g", TaskUuid: "1", IsFinished: true, Response: spilled, ResponseEncoding: encoding},
+	)
+
+	assert.Empty(t, resolution.Ready)
+
+	// the first result is collected before the second dependency finishes
+	finished.TakeByUuid("g", "1")
+	payloads.Release(spilled, encoding)
+
+	resolution = dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "2", IsFinished: true, Response: "two"})
+
+	assert.Equal(t, []*Task{dependent}, resolution.Ready)
+	assert.JSONEq(t, `{"Payload":"","Dependencies":{"1":"one","2":"two"}}`, dependent.Payload)
+
+	assert.Empty(t, dependencies.shard("g").groups, "the group is dropped after the last dependent")
+
+	dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "3", IsFinished: true, Response: "three"})
+
+	late := &Task{
+		GroupUuid:          "g",
+		TaskUuid:           "l",
+		UnixTimeout:        unixTimeout,
+		DependsOn:          []string{"3"},
+		InjectDependencies: true,
+	}
+
+	resolution, err = dependencies.Hold(late)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*Task{late}, resolution.Ready, "the result is taken from the finished tasks")
+	assert.JSONEq(t, `{"Payload":"","Dependencies":{"3":"three"}}`, late.Payload)
+
+	_, err = dependencies.Hold(&Task{GroupUuid: "g", TaskUuid: "m", UnixTimeout: unixTimeout, DependsOn: []string{"1"}})
+
+	assert.EqualError(t, err, "task [m] depends on unknown task [1]", "collected tasks are not known anymore")
+}
+
+func TestDependencies_PacksInjectedPayload(t *testing.T) {
+	dependencies := NewDependencies(NewShardedSubTasks(neverExpiry), payloads.NewPacker(0, 10, t.TempDir()))
+
+	dependent := &Task{
+		GroupUuid:          "g",
+		TaskUuid:           "d",
+		UnixTimeout:        int(time.Now().Unix()) + 60,
+		Payload:            "payload",
+		DependsOn:          []string{"1"},
+		InjectDependencies: true,
+	}
+
+	dependencies.AddPending(&Task{GroupUuid: "g", TaskUuid: "1"})
+
+	_, err := dependencies.Hold(dependent)
+	assert.NoError(t, err)
+
+	resolution := dependencies.Resolve(&Task{GroupUuid: "g", TaskUuid: "1", IsFinished: true, Response: "one"})
+
+	assert.Equal(t, []*Task{dependent}, resolution.Ready)
+	assert.Equal(t, payloads.EncodingSpill, dependent.PayloadEncoding)
+
+	payload, err := payloads.Unpack(dependent.Payload, dependent.PayloadEncoding)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Payload":"payload","Dependencies":{"1":"one"}}`, payload)
+
+	payloads.Release(dependent.Payload, dependent.PayloadEncoding)
+}
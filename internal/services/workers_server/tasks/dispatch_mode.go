@@ -0,0 +1,23 @@
+package tasks
+
+type DispatchMode string
+
+const (
+	// DispatchModeFifo takes tasks from the oldest group until it drains
+	DispatchModeFifo DispatchMode = "fifo"
+	// DispatchModeRoundRobin rotates across active groups
+	DispatchModeRoundRobin DispatchMode = "round_robin"
+	// DispatchModeTenantRoundRobin rotates across tenants and then across groups of the tenant
+	DispatchModeTenantRoundRobin DispatchMode = "tenant_round_robin"
+)
+
+func ParseDispatchMode(value string) DispatchMode {
+	switch DispatchMode(value) {
+	case DispatchModeRoundRobin:
+		return DispatchModeRoundRobin
+	case DispatchModeTenantRoundRobin:
+		return DispatchModeTenantRoundRobin
+	default:
+		return DispatchModeFifo
+	}
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"sync"
+	"time"
+)
+
+type GroupsRetention struct {
+	mutex  sync.Mutex
+	groups map[string]*groupRetention
+}
+
+type groupRetention struct {
+	waitingTtl int
+	resultTtl  int
+	keptUntil  time.Time
+}
+
+func NewGroupsRetention() *GroupsRetention {
+	return &GroupsRetention{
+		groups: make(map[string]*groupRetention),
+	}
+}
+
+func (g *GroupsRetention) Set(groupUuid string, waitingTtl int, resultTtl int) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if _, exists := g.groups[groupUuid]; exists {
+		return false
+	}
+
+	g.groups[groupUuid] = &groupRetention{
+		waitingTtl: waitingTtl,
+		resultTtl:  resultTtl,
+		keptUntil:  time.Now().Add(time.Minute), // until the task which set them is stored
+	}
+
+	return true
+}
+
+func (g *GroupsRetention) Get(groupUuid string) (waitingTtl int, resultTtl int) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	group, exists := g.groups[groupUuid]
+
+	if !exists {
+		return 0, 0
+	}
+
+	return group.waitingTtl, group.resultTtl
+}
+
+func (g *GroupsRetention) Keep(groupUuid string, at time.Time) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if group, exists := g.groups[groupUuid]; exists && at.After(group.keptUntil) {
+		group.keptUntil = at
+	}
+}
+
+func (g *GroupsRetention) Delete(groupUuid string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	delete(g.groups, groupUuid)
+}
+
+func (g *GroupsRetention) FlushStale(now time.Time) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for groupUuid, group := range g.groups {
+		if now.After(group.keptUntil) {
+			delete(g.groups, groupUuid)
+		}
+	}
+}
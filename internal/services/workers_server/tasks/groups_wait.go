@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"sync"
+	"time"
+)
+
+type GroupsWait struct {
+	mutex  sync.Mutex
+	groups map[string]*groupWait
+}
+
+type groupWait struct {
+	dispatchedCount int
+	totalWait       time.Duration
+	maxWait         time.Duration
+	lastDispatchAt  time.Time
+}
+
+type GroupWaitStats struct {
+	DispatchedCount int
+	AvgWaitMs       int64
+	MaxWaitMs       int64
+}
+
+func NewGroupsWait() *GroupsWait {
+	return &GroupsWait{
+		groups: make(map[string]*groupWait),
+	}
+}
+
+func (g *GroupsWait) Add(groupUuid string, wait time.Duration) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	group, exists := g.groups[groupUuid]
+
+	if !exists {
+		group = &groupWait{}
+
+		g.groups[groupUuid] = group
+	}
+
+	group.dispatchedCount += 1
+	group.totalWait += wait
+	group.lastDispatchAt = time.Now()
+
+	if wait > group.maxWait {
+		group.maxWait = wait
+	}
+}
+
+func (g *GroupsWait) FlushStale(ttl time.Duration) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for groupUuid, group := range g.groups {
+		if time.Since(group.lastDispatchAt) > ttl {
+			delete(g.groups, groupUuid)
+		}
+	}
+}
+
+func (g *GroupsWait) Stats() map[string]GroupWaitStats {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	stats := make(map[string]GroupWaitStats, len(g.groups))
+
+	for groupUuid, group := range g.groups {
+		stats[groupUuid] = GroupWaitStats{
+			DispatchedCount: group.dispatchedCount,
+			AvgWaitMs:       (group.totalWait / time.Duration(group.dispatchedCount)).Milliseconds(),
+			MaxWaitMs:       group.maxWait.Milliseconds(),
+		}
+	}
+
+	return stats
+}
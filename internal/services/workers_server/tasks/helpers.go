@@ -7,3 +7,7 @@ func isTimeout(unixTimeout int, headStart int) bool {
 
 	return (int64(unixTimeout) - now) < -int64(headStart)
 }
+
+func deadline(unixTimeout int, headStart int) time.Time {
+	return time.Unix(int64(unixTimeout)+int64(headStart), 0)
+}
@@ -0,0 +1,138 @@
+package tasks
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+const latencySamplesLimit = 10000
+
+var latencyWindows = map[string]time.Duration{
+	"1m": time.Minute,
+	"5m": 5 * time.Minute,
+}
+
+type Latencies struct {
+	queueWait  *SlidingWindow
+	execution  *SlidingWindow
+	collection *SlidingWindow
+}
+
+type LatenciesStats struct {
+	QueueWait  map[string]PercentilesStats
+	Execution  map[string]PercentilesStats
+	Collection map[string]PercentilesStats
+}
+
+type PercentilesStats struct {
+	Count int
+	P50Ms int64
+	P90Ms int64
+	P99Ms int64
+}
+
+type SlidingWindow struct {
+	mutex   sync.Mutex
+	samples []latencySample
+	next    int
+}
+
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+func NewLatencies() *Latencies {
+	return &Latencies{
+		queueWait:  NewSlidingWindow(latencySamplesLimit),
+		execution:  NewSlidingWindow(latencySamplesLimit),
+		collection: NewSlidingWindow(latencySamplesLimit),
+	}
+}
+
+func (l *Latencies) Stats() LatenciesStats {
+	return LatenciesStats{
+		QueueWait:  l.queueWait.Stats(),
+		Execution:  l.execution.Stats(),
+		Collection: l.collection.Stats(),
+	}
+}
+
+func NewSlidingWindow(limit int) *SlidingWindow {
+	return &SlidingWindow{
+		samples: make([]latencySample, 0, limit),
+	}
+}
+
+func (w *SlidingWindow) Add(duration time.Duration) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	sample := latencySample{
+		at:       time.Now(),
+		duration: duration,
+	}
+
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, sample)
+
+		return
+	}
+
+	w.samples[w.next] = sample
+
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+func (w *SlidingWindow) Stats() map[string]PercentilesStats {
+	return w.statsAt(time.Now())
+}
+
+// statsAt copies the samples under the lock and sorts them after releasing it, so Add is not blocked
+func (w *SlidingWindow) statsAt(now time.Time) map[string]PercentilesStats {
+	w.mutex.Lock()
+	samples := slices.Clone(w.samples)
+	w.mutex.Unlock()
+
+	slices.SortFunc(samples, func(a, b latencySample) int {
+		return cmp.Compare(a.duration, b.duration)
+	})
+
+	stats := make(map[string]PercentilesStats, len(latencyWindows))
+
+	for name, window := range latencyWindows {
+		// filtering keeps the durations sorted
+		durations := make([]time.Duration, 0, len(samples))
+
+		for _, sample := range samples {
+			if now.Sub(sample.at) <= window {
+				durations = append(durations, sample.duration)
+			}
+		}
+
+		stats[name] = PercentilesStats{
+			Count: len(durations),
+			P50Ms: percentile(durations, 50).Milliseconds(),
+			P90Ms: percentile(durations, 90).Milliseconds(),
+			P99Ms: percentile(durations, 99).Milliseconds(),
+		}
+	}
+
+	return stats
+}
+
+func percentile(sorted []time.Duration, percent int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	index := (len(sorted)*percent+99)/100 - 1
+
+	if index < 0 {
+		index = 0
+	}
+
+	return sorted[index]
+}
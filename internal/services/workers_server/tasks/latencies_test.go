@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	hundred := make([]time.Duration, 100)
+
+	for i := range hundred {
+		hundred[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	cases := []struct {
+		name     string
+		sorted   []time.Duration
+		percent  int
+		expected time.Duration
+	}{
+		{name: "empty", sorted: nil, percent: 50, expected: 0},
+		{name: "single", sorted: []time.Duration{7}, percent: 99, expected: 7},
+		{name: "p50 of two", sorted: []time.Duration{1, 2}, percent: 50, expected: 1},
+		{name: "p90 of two", sorted: []time.Duration{1, 2}, percent: 90, expected: 2},
+		{name: "p50 of hundred", sorted: hundred, percent: 50, expected: 50 * time.Millisecond},
+		{name: "p99 of hundred", sorted: hundred, percent: 99, expected: 99 * time.Millisecond},
+		{name: "p0", sorted: hundred, percent: 0, expected: time.Millisecond},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, percentile(testCase.sorted, testCase.percent))
+		})
+	}
+}
+
+func TestSlidingWindow_Expiring(t *testing.T) {
+	now := time.Now()
+
+	cases := []struct {
+		name     string
+		ages     []time.Duration
+		expected map[string]PercentilesStats
+	}{
+		{
+			name: "all in the windows",
+			ages: []time.Duration{0, 30 * time.Second},
+			expected: map[string]PercentilesStats{
+				"1m": {Count: 2, P50Ms: 1000, P90Ms: 2000, P99Ms: 2000},
+				"5m": {Count: 2, P50Ms: 1000, P90Ms: 2000, P99Ms: 2000},
+			},
+		},
+		{
+			name: "expired from the short window",
+			ages: []time.Duration{0, 2 * time.Minute},
+			expected: map[string]PercentilesStats{
+				"1m": {Count: 1, P50Ms: 1000, P90Ms: 1000, P99Ms: 1000},
+				"5m": {Count: 2, P50Ms: 1000, P90Ms: 2000, P99Ms: 2000},
+			},
+		},
+		{
+			name: "expired from all windows",
+			ages: []time.Duration{10 * time.Minute, 6 * time.Minute},
+			expected: map[string]PercentilesStats{
+				"1m": {},
+				"5m": {},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			window := NewSlidingWindow(10)
+
+			// the n-th sample takes n seconds
+			for i, age := range testCase.ages {
+				window.samples = append(window.samples, latencySample{
+					at:       now.Add(-age),
+					duration: time.Duration(i+1) * time.Second,
+				})
+			}
+
+			assert.Equal(t, testCase.expected, window.statsAt(now))
+		})
+	}
+}
+
+func TestSlidingWindow_Limit(t *testing.T) {
+	window := NewSlidingWindow(3)
+
+	for i := 1; i <= 5; i++ {
+		window.Add(time.Duration(i) * time.Second)
+	}
+
+	stats := window.Stats()["1m"]
+
+	assert.Equal(t, 3, stats.Count)
+	assert.Equal(t, int64(4000), stats.P50Ms)
+	assert.Equal(t, int64(5000), stats.P99Ms)
+}
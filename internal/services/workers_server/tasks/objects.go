@@ -1,10 +1,24 @@
 package tasks
 
-import "sync/atomic"
+import (
+	"container/list"
+	"sparallel_server/internal/services/workers_server/events"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"sync/atomic"
+	"time"
+)
 
 type Tasks struct {
-	waiting  *SubTasks
-	finished *SubTasks
+	waiting      *SubTasks
+	finished     *ShardedSubTasks
+	dependencies *Dependencies
+	groupsWait   *GroupsWait
+	retention    *GroupsRetention
+	latencies    *Latencies
+	events       *events.Events
+
+	finishedListeners []func(task *Task)
+	waitingListeners  []func()
 
 	addedTotalCount    atomic.Int64
 	reAddedTotalCount  atomic.Int64
@@ -13,57 +27,179 @@ type Tasks struct {
 	successTotalCount  atomic.Int64
 	errorTotalCount    atomic.Int64
 	timeoutTotalCount  atomic.Int64
+	evictedTotalCount  atomic.Int64
+
+	waitingTtl       atomic.Int64
+	resultTtl        atomic.Int64
+	finishedMaxBytes atomic.Int64
 }
 
 type Group struct {
-	uuid        string
-	unixTimeout int
-	tasks       map[string]*Task // map[TaskUuid]
+	uuid         string
+	tenantId     string
+	nextExpiryAt time.Time // no task of the group expires before, it may be earlier than the actual one
+	tasks        map[string]*list.Element
+	queue        *list.List
+
+	orderElement  *list.Element
+	tenantElement *list.Element
+}
+
+func newGroup(uuid string, tenantId string) *Group {
+	return &Group{
+		uuid:     uuid,
+		tenantId: tenantId,
+		tasks:    make(map[string]*list.Element),
+		queue:    list.New(),
+	}
+}
+
+func (g *Group) mayHaveExpired(now time.Time) bool {
+	return now.After(g.nextExpiryAt)
+}
+
+func (g *Group) front() *Task {
+	element := g.queue.Front()
+
+	if element == nil {
+		return nil
+	}
+
+	return element.Value.(*Task)
+}
+
+func (g *Group) get(taskUuid string) *Task {
+	element, exists := g.tasks[taskUuid]
+
+	if !exists {
+		return nil
+	}
+
+	return element.Value.(*Task)
+}
+
+func (g *Group) push(task *Task) {
+	g.tasks[task.TaskUuid] = g.queue.PushBack(task)
+}
+
+func (g *Group) remove(task *Task) {
+	element, exists := g.tasks[task.TaskUuid]
+
+	if !exists {
+		return
+	}
+
+	g.queue.Remove(element)
+
+	delete(g.tasks, task.TaskUuid)
 }
 
-func (g *Group) IsTimeout() bool {
-	return isTimeout(g.unixTimeout, 5)
+func (g *Group) list() []*Task {
+	tasks := make([]*Task, 0, g.queue.Len())
+
+	for element := g.queue.Front(); element != nil; element = element.Next() {
+		tasks = append(tasks, element.Value.(*Task))
+	}
+
+	return tasks
+}
+
+type AddTaskArgs struct {
+	GroupUuid          string
+	TaskUuid           string
+	TenantId           string
+	UnixTimeout        int
+	Payload            string
+	DependsOn          []string
+	InjectDependencies bool
+	RequiredTags       []string
+	RoutingKey         string
+	GroupRateLimit     float64
+	GroupRateBurst     int
+	WaitingTtl         int
+	ResultTtl          int
+	Callback           string
 }
 
 type Task struct {
-	GroupUuid   string
-	TaskUuid    string
-	UnixTimeout int
-	Payload     string
-	IsFinished  bool
-	Response    string
-	IsError     bool
+	GroupUuid          string
+	TaskUuid           string
+	TenantId           string
+	UnixTimeout        int
+	Payload            string
+	PayloadEncoding    payloads.Encoding
+	DependsOn          []string
+	InjectDependencies bool
+	RequiredTags       []string
+	RoutingKey         string
+	Callback           string
+	IsFinished         bool
+	Response           string
+	ResponseEncoding   payloads.Encoding
+	IsError            bool
+	TimedOut           bool
+	EnqueuedAt         time.Time
+	DispatchedAt       time.Time
+	FinishedAt         time.Time
+	CollectedAt        time.Time
+
+	storedSize int
+	sequence   uint64
+	expiresAt  time.Time
 }
 
 func (t *Task) IsTimeout() bool {
 	return isTimeout(t.UnixTimeout, 5)
 }
 
+// size counts the spill files too, so they take their share of the finished results cap
+func (t *Task) size() int {
+	return payloads.Size(t.Payload, t.PayloadEncoding) + payloads.Size(t.Response, t.ResponseEncoding)
+}
+
+func (t *Task) Deadline() time.Time {
+	return deadline(t.UnixTimeout, 5)
+}
+
 type OrderedGroups struct {
 	data  map[string]*Group
-	order []string
+	order *list.List
 }
 
 func NewOrderedGroups() *OrderedGroups {
 	return &OrderedGroups{
 		data:  make(map[string]*Group),
-		order: make([]string, 0),
+		order: list.New(),
 	}
 }
 
-func (m *OrderedGroups) Add(groupUuid string, group *Group) {
-	if _, exists := m.data[groupUuid]; !exists {
-		m.order = append(m.order, groupUuid)
+func (m *OrderedGroups) Get(groupUuid string) *Group {
+	return m.data[groupUuid]
+}
+
+func (m *OrderedGroups) Add(group *Group) {
+	if existing, exists := m.data[group.uuid]; exists {
+		m.Delete(existing)
 	}
-	m.data[groupUuid] = group
+
+	group.orderElement = m.order.PushBack(group)
+
+	m.data[group.uuid] = group
 }
 
-func (m *OrderedGroups) Delete(groupUuid string) {
-	delete(m.data, groupUuid)
-	for i, uuid := range m.order {
-		if uuid == groupUuid {
-			m.order = append(m.order[:i], m.order[i+1:]...)
-			break
-		}
+func (m *OrderedGroups) Delete(group *Group) {
+	if m.data[group.uuid] != group {
+		return
 	}
+
+	m.order.Remove(group.orderElement)
+
+	delete(m.data, group.uuid)
+}
+
+type tenantGroups struct {
+	id      string
+	groups  *list.List
+	cursor  *list.Element
+	element *list.Element
 }
@@ -0,0 +1,140 @@
+package tasks
+
+import (
+	"time"
+)
+
+const (
+	shardsCount = 32
+
+	// maxEvictedCount bounds the work of one EvictOldest call, the rest is evicted by the next calls
+	maxEvictedCount = 256
+)
+
+// ShardedSubTasks spreads groups over stores with their own locks, so clients of different groups
+// don't wait for each other. It has no dispatch order, so it keeps finished tasks only.
+type ShardedSubTasks struct {
+	shards [shardsCount]*SubTasks
+}
+
+func NewShardedSubTasks(expiry func(task *Task) time.Time) *ShardedSubTasks {
+	sharded := &ShardedSubTasks{}
+
+	for i := range sharded.shards {
+		sharded.shards[i] = NewSubTasks(expiry)
+	}
+
+	return sharded
+}
+
+func (s *ShardedSubTasks) AddTask(task *Task) {
+	s.shard(task.GroupUuid).AddTask(task)
+}
+
+func (s *ShardedSubTasks) DeleteGroup(groupUuid string) []*Task {
+	return s.shard(groupUuid).DeleteGroup(groupUuid)
+}
+
+func (s *ShardedSubTasks) DeleteTask(task *Task) bool {
+	return s.shard(task.GroupUuid).DeleteTask(task)
+}
+
+func (s *ShardedSubTasks) TakeFirstByGroupUuid(groupUuid string) *Task {
+	return s.shard(groupUuid).TakeFirstByGroupUuid(groupUuid)
+}
+
+func (s *ShardedSubTasks) TakeByUuid(groupUuid string, taskUuid string) *Task {
+	return s.shard(groupUuid).TakeByUuid(groupUuid, taskUuid)
+}
+
+func (s *ShardedSubTasks) GetByUuid(groupUuid string, taskUuid string) *Task {
+	return s.shard(groupUuid).GetByUuid(groupUuid, taskUuid)
+}
+
+func (s *ShardedSubTasks) ListByGroupUuid(groupUuid string) []*Task {
+	return s.shard(groupUuid).ListByGroupUuid(groupUuid)
+}
+
+func (s *ShardedSubTasks) FlushRotten() []*Task {
+	var flushed []*Task
+
+	for _, shard := range s.shards {
+		flushed = append(flushed, shard.FlushRotten()...)
+	}
+
+	return flushed
+}
+
+func (s *ShardedSubTasks) EvictOldest(maxBytes int) []*Task {
+	if maxBytes <= 0 || s.GetBytes() <= maxBytes {
+		return nil
+	}
+
+	var sequences [shardsCount]uint64
+	var exists [shardsCount]bool
+
+	for i, shard := range s.shards {
+		sequences[i], exists[i] = shard.oldestSequence()
+	}
+
+	var evicted []*Task
+
+	for len(evicted) < maxEvictedCount && s.GetBytes() > maxBytes {
+		oldest := -1
+
+		for i := range s.shards {
+			if exists[i] && (oldest == -1 || sequences[i] < sequences[oldest]) {
+				oldest = i
+			}
+		}
+
+		if oldest == -1 {
+			break
+		}
+
+		// the task may be taken meanwhile, then the next oldest one of the shard is looked up
+		if task := s.shards[oldest].evictOldestOne(sequences[oldest]); task != nil {
+			evicted = append(evicted, task)
+		}
+
+		sequences[oldest], exists[oldest] = s.shards[oldest].oldestSequence()
+	}
+
+	return evicted
+}
+
+func (s *ShardedSubTasks) GetBytes() int {
+	var bytes int
+
+	for _, shard := range s.shards {
+		bytes += shard.GetBytes()
+	}
+
+	return bytes
+}
+
+func (s *ShardedSubTasks) GetCount() int {
+	var count int
+
+	for _, shard := range s.shards {
+		count += shard.GetCount()
+	}
+
+	return count
+}
+
+func (s *ShardedSubTasks) shard(groupUuid string) *SubTasks {
+	return s.shards[shardIndex(groupUuid)]
+}
+
+// shardIndex picks the shard by FNV-1a hash of the group, inlined to avoid allocations
+func shardIndex(groupUuid string) uint32 {
+	hash := uint32(2166136261)
+
+	for i := 0; i < len(groupUuid); i++ {
+		hash ^= uint32(groupUuid[i])
+		hash *= 16777619
+	}
+
+	return hash % shardsCount
+}
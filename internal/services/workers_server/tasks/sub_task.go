@@ -1,79 +1,147 @@
 package tasks
 
 import (
+	"container/list"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+var sequence atomic.Uint64
+
 type SubTasks struct {
 	mutex  sync.Mutex
 	groups *OrderedGroups
+
+	mode   DispatchMode
+	cursor *list.Element
+
+	tenants      *list.List
+	tenantsIndex map[string]*tenantGroups
+	tenantCursor *list.Element
+
+	expiry   func(task *Task) time.Time
+	queue    *list.List
+	elements map[*Task]*list.Element
+
+	count atomic.Int64
+	bytes atomic.Int64
 }
 
-func NewSubTasks() *SubTasks {
+func NewSubTasks(expiry func(task *Task) time.Time) *SubTasks {
 	return &SubTasks{
-		mutex:  sync.Mutex{},
-		groups: NewOrderedGroups(),
+		mutex:        sync.Mutex{},
+		groups:       NewOrderedGroups(),
+		mode:         DispatchModeFifo,
+		tenants:      list.New(),
+		tenantsIndex: make(map[string]*tenantGroups),
+		expiry:       expiry,
+		queue:        list.New(),
+		elements:     make(map[*Task]*list.Element),
 	}
 }
 
+func (s *SubTasks) SetDispatchMode(mode DispatchMode) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.mode = mode
+}
+
 func (s *SubTasks) AddTask(task *Task) {
+	// the size of a spill file is read before locking
+	size := task.size()
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	group, exists := s.groups.data[task.GroupUuid]
+	group := s.groups.Get(task.GroupUuid)
 
-	if !exists {
-		group = &Group{
-			uuid:        task.GroupUuid,
-			unixTimeout: task.UnixTimeout,
-			tasks:       make(map[string]*Task),
-		}
-		s.groups.Add(task.GroupUuid, group)
+	if group == nil {
+		group = newGroup(task.GroupUuid, task.TenantId)
+
+		s.addGroup(group)
+	}
+
+	if existing := group.get(task.TaskUuid); existing != nil {
+		group.remove(existing)
+
+		s.forget(existing)
 	}
 
-	group.tasks[task.TaskUuid] = task
+	group.push(task)
+
+	task.storedSize = size
+	task.sequence = sequence.Add(1)
+	task.expiresAt = s.expiry(task)
+
+	s.elements[task] = s.queue.PushBack(task)
+
+	s.count.Add(1)
+	s.bytes.Add(int64(task.storedSize))
+
+	if group.queue.Len() == 1 || task.expiresAt.Before(group.nextExpiryAt) {
+		group.nextExpiryAt = task.expiresAt
+	}
 }
 
-func (s *SubTasks) DeleteGroup(groupUuid string) {
+func (s *SubTasks) DeleteGroup(groupUuid string) []*Task {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	s.groups.Delete(groupUuid)
+	group := s.groups.Get(groupUuid)
+
+	if group == nil {
+		return nil
+	}
+
+	return s.deleteGroup(group)
 }
 
-func (s *SubTasks) DeleteTask(task *Task) {
+func (s *SubTasks) DeleteTask(task *Task) bool {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	group, exists := s.groups.data[task.GroupUuid]
+	group := s.groups.Get(task.GroupUuid)
 
-	if !exists {
-		return
+	if group == nil || group.get(task.TaskUuid) != task {
+		return false
 	}
 
-	delete(group.tasks, task.TaskUuid)
+	s.detach(group, task)
 
-	if len(group.tasks) == 0 {
-		s.groups.Delete(task.GroupUuid)
-	}
+	return true
 }
 
 func (s *SubTasks) Pop() *Task {
+	return s.PopAllowed(nil)
+}
+
+func (s *SubTasks) PopAllowed(allow func(task *Task) bool) *Task {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	// Итерация по группам в порядке их добавления
-	for _, groupUuid := range s.groups.order {
-		group := s.groups.data[groupUuid]
-		for taskUuid, task := range group.tasks {
-			delete(group.tasks, taskUuid)
+	group := s.nextGroup()
 
-			if len(group.tasks) == 0 {
-				s.groups.Delete(group.uuid)
-			}
+	if group == nil {
+		return nil
+	}
+
+	if task := s.popFromGroup(group, allow); task != nil || allow == nil {
+		return task
+	}
 
-			return task
+	for element := s.groups.order.Front(); element != nil; {
+		// the group may be deleted by popping
+		next := element.Next()
+
+		if other := element.Value.(*Group); other != group {
+			if task := s.popFromGroup(other, allow); task != nil {
+				return task
+			}
 		}
+
+		element = next
 	}
 
 	return nil
@@ -83,52 +151,313 @@ func (s *SubTasks) TakeFirstByGroupUuid(groupUuid string) *Task {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	group, exists := s.groups.data[groupUuid]
+	group := s.groups.Get(groupUuid)
 
-	if !exists {
+	if group == nil {
 		return nil
 	}
 
-	for taskUuid, task := range group.tasks {
-		delete(group.tasks, taskUuid)
+	return s.popFromGroup(group, nil)
+}
 
-		if len(group.tasks) == 0 {
-			s.groups.Delete(group.uuid)
-		}
+func (s *SubTasks) TakeByUuid(groupUuid string, taskUuid string) *Task {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-		return task
+	group := s.groups.Get(groupUuid)
+
+	if group == nil {
+		return nil
 	}
 
-	return nil
+	task := group.get(taskUuid)
+
+	if task == nil {
+		return nil
+	}
+
+	s.detach(group, task)
+
+	return task
 }
 
-func (s *SubTasks) FlushFirstRotten() int {
+func (s *SubTasks) GetByUuid(groupUuid string, taskUuid string) *Task {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	for _, groupUuid := range s.groups.order {
-		group := s.groups.data[groupUuid]
-		if !group.IsTimeout() {
-			continue
+	group := s.groups.Get(groupUuid)
+
+	if group == nil {
+		return nil
+	}
+
+	task := group.get(taskUuid)
+
+	if task == nil {
+		return nil
+	}
+
+	taskCopy := *task
+
+	return &taskCopy
+}
+
+func (s *SubTasks) ListByGroupUuid(groupUuid string) []*Task {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	group := s.groups.Get(groupUuid)
+
+	if group == nil {
+		return nil
+	}
+
+	tasks := group.list()
+
+	for i, task := range tasks {
+		taskCopy := *task
+
+		tasks[i] = &taskCopy
+	}
+
+	return tasks
+}
+
+func (s *SubTasks) FlushRotten() []*Task {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var flushed []*Task
+
+	now := time.Now()
+
+	for element := s.groups.order.Front(); element != nil; {
+		next := element.Next()
+
+		if group := element.Value.(*Group); group.mayHaveExpired(now) {
+			flushed = append(flushed, s.flushGroup(group, now)...)
 		}
 
-		itemsCount := len(group.tasks)
-		s.groups.Delete(group.uuid)
-		return itemsCount
+		element = next
+	}
+
+	return flushed
+}
+
+func (s *SubTasks) EvictOldest(maxBytes int) []*Task {
+	if maxBytes <= 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var evicted []*Task
+
+	for s.bytes.Load() > int64(maxBytes) && s.queue.Len() > 0 {
+		evicted = append(evicted, s.evictFront())
 	}
 
-	return 0
+	return evicted
+}
+
+func (s *SubTasks) GetBytes() int {
+	return int(s.bytes.Load())
 }
 
 func (s *SubTasks) GetCount() int {
+	return int(s.count.Load())
+}
+
+func (s *SubTasks) oldestSequence() (uint64, bool) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	var count int
+	element := s.queue.Front()
+
+	if element == nil {
+		return 0, false
+	}
+
+	return element.Value.(*Task).sequence, true
+}
+
+func (s *SubTasks) evictOldestOne(expectedSequence uint64) *Task {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	element := s.queue.Front()
+
+	if element == nil || element.Value.(*Task).sequence != expectedSequence {
+		return nil
+	}
+
+	return s.evictFront()
+}
+
+func (s *SubTasks) evictFront() *Task {
+	task := s.queue.Front().Value.(*Task)
+
+	s.detach(s.groups.Get(task.GroupUuid), task)
+
+	return task
+}
+
+func (s *SubTasks) nextGroup() *Group {
+	if s.groups.order.Len() == 0 {
+		return nil
+	}
+
+	switch s.mode {
+	case DispatchModeRoundRobin:
+		element := s.cursor
+
+		if element == nil {
+			element = s.groups.order.Front()
+		}
+
+		s.cursor = element.Next()
+
+		return element.Value.(*Group)
+	case DispatchModeTenantRoundRobin:
+		tenantElement := s.tenantCursor
+
+		if tenantElement == nil {
+			tenantElement = s.tenants.Front()
+		}
+
+		s.tenantCursor = tenantElement.Next()
+
+		tenant := tenantElement.Value.(*tenantGroups)
+
+		element := tenant.cursor
+
+		if element == nil {
+			element = tenant.groups.Front()
+		}
+
+		tenant.cursor = element.Next()
+
+		return element.Value.(*Group)
+	default:
+		return s.groups.order.Front().Value.(*Group)
+	}
+}
+
+func (s *SubTasks) popFromGroup(group *Group, allow func(task *Task) bool) *Task {
+	task := group.front()
+
+	if task == nil {
+		return nil
+	}
+
+	if allow != nil && !allow(task) {
+		return nil
+	}
+
+	s.detach(group, task)
+
+	return task
+}
+
+func (s *SubTasks) flushGroup(group *Group, now time.Time) []*Task {
+	var flushed []*Task
+	var nextExpiryAt time.Time
+
+	for _, task := range group.list() {
+		if now.After(task.expiresAt) {
+			flushed = append(flushed, task)
+
+			s.detach(group, task)
+
+			continue
+		}
+
+		if nextExpiryAt.IsZero() || task.expiresAt.Before(nextExpiryAt) {
+			nextExpiryAt = task.expiresAt
+		}
+	}
+
+	group.nextExpiryAt = nextExpiryAt
+
+	return flushed
+}
+
+func (s *SubTasks) detach(group *Group, task *Task) {
+	group.remove(task)
+
+	s.forget(task)
+
+	if group.queue.Len() == 0 {
+		s.deleteGroup(group)
+	}
+}
+
+func (s *SubTasks) forget(task *Task) {
+	element, exists := s.elements[task]
+
+	if !exists {
+		return
+	}
+
+	s.queue.Remove(element)
+
+	delete(s.elements, task)
+
+	s.count.Add(-1)
+	s.bytes.Add(-int64(task.storedSize))
+}
+
+func (s *SubTasks) addGroup(group *Group) {
+	s.groups.Add(group)
+
+	tenant, exists := s.tenantsIndex[group.tenantId]
+
+	if !exists {
+		tenant = &tenantGroups{
+			id:     group.tenantId,
+			groups: list.New(),
+		}
+
+		tenant.element = s.tenants.PushBack(tenant)
+
+		s.tenantsIndex[group.tenantId] = tenant
+	}
+
+	group.tenantElement = tenant.groups.PushBack(group)
+}
+
+func (s *SubTasks) deleteGroup(group *Group) []*Task {
+	tasks := group.list()
+
+	for _, task := range tasks {
+		s.forget(task)
+	}
+
+	// the cursors move to the groups which take the place of the deleted one
+	if s.cursor == group.orderElement {
+		s.cursor = group.orderElement.Next()
+	}
+
+	s.groups.Delete(group)
+
+	tenant := s.tenantsIndex[group.tenantId]
+
+	if tenant.cursor == group.tenantElement {
+		tenant.cursor = group.tenantElement.Next()
+	}
+
+	tenant.groups.Remove(group.tenantElement)
+
+	if tenant.groups.Len() == 0 {
+		if s.tenantCursor == tenant.element {
+			s.tenantCursor = tenant.element.Next()
+		}
+
+		s.tenants.Remove(tenant.element)
 
-	for _, group := range s.groups.data {
-		count += len(group.tasks)
+		delete(s.tenantsIndex, tenant.id)
 	}
 
-	return count
+	return tasks
 }
@@ -0,0 +1,180 @@
+package tasks
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	benchTasksCount  = 100_000
+	benchGroupsCount = 1_000
+)
+
+type benchStore interface {
+	AddTask(task *Task)
+	TakeFirstByGroupUuid(groupUuid string) *Task
+}
+
+func fillBenchTasks(store benchStore, groupsCount int) []string {
+	groupUuids := make([]string, groupsCount)
+
+	for i := range groupUuids {
+		groupUuids[i] = "group-" + strconv.Itoa(i)
+	}
+
+	for i := range benchTasksCount {
+		store.AddTask(&Task{
+			GroupUuid: groupUuids[i%groupsCount],
+			TaskUuid:  "task-" + strconv.Itoa(i),
+		})
+	}
+
+	return groupUuids
+}
+
+func benchmarkPop(b *testing.B, mode DispatchMode) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	subTasks.SetDispatchMode(mode)
+
+	fillBenchTasks(subTasks, benchGroupsCount)
+
+	b.ResetTimer()
+
+	for range b.N {
+		// the popped task is put back to keep 100k tasks queued
+		subTasks.AddTask(subTasks.Pop())
+	}
+}
+
+func BenchmarkSubTasks_PopFifo(b *testing.B) {
+	benchmarkPop(b, DispatchModeFifo)
+}
+
+func BenchmarkSubTasks_PopRoundRobin(b *testing.B) {
+	benchmarkPop(b, DispatchModeRoundRobin)
+}
+
+func BenchmarkSubTasks_DeleteGroup(b *testing.B) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	// groups of 10 tasks
+	groupUuids := fillBenchTasks(subTasks, benchTasksCount/10)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		groupUuid := groupUuids[i%len(groupUuids)]
+
+		for _, task := range subTasks.DeleteGroup(groupUuid) {
+			subTasks.AddTask(task)
+		}
+	}
+}
+
+func BenchmarkSubTasks_GetCount(b *testing.B) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	fillBenchTasks(subTasks, benchGroupsCount)
+
+	b.ResetTimer()
+
+	for range b.N {
+		_ = subTasks.GetCount()
+	}
+}
+
+func benchmarkTakeParallel(b *testing.B, store benchStore) {
+	groupUuids := fillBenchTasks(store, benchGroupsCount)
+
+	var next atomic.Int64
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			groupUuid := groupUuids[next.Add(1)%int64(len(groupUuids))]
+
+			if task := store.TakeFirstByGroupUuid(groupUuid); task != nil {
+				store.AddTask(task)
+			}
+		}
+	})
+}
+
+func BenchmarkSubTasks_TakeParallel(b *testing.B) {
+	benchmarkTakeParallel(b, NewSubTasks(neverExpiry))
+}
+
+func BenchmarkShardedSubTasks_TakeParallel(b *testing.B) {
+	benchmarkTakeParallel(b, NewShardedSubTasks(neverExpiry))
+}
+
+// BenchmarkSubTasks_AddWhileDispatching adds tasks from parallel clients while the single dispatcher pops them,
+// which is how the waiting tasks are used
+func BenchmarkSubTasks_AddWhileDispatching(b *testing.B) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	groupUuids := fillBenchTasks(subTasks, benchGroupsCount)
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				subTasks.Pop()
+			}
+		}
+	}()
+
+	var next atomic.Int64
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i := next.Add(1)
+
+			subTasks.AddTask(&Task{
+				GroupUuid: groupUuids[i%int64(len(groupUuids))],
+				TaskUuid:  "added-" + strconv.FormatInt(i, 10),
+			})
+		}
+	})
+
+	b.StopTimer()
+
+	close(done)
+	<-stopped
+}
+
+// BenchmarkShardedSubTasks_PopFifo pops the oldest task of all shards the way a sharded waiting store
+// would have to keep the dispatch order, every pop peeks all shards
+func BenchmarkShardedSubTasks_PopFifo(b *testing.B) {
+	subTasks := NewShardedSubTasks(neverExpiry)
+
+	fillBenchTasks(subTasks, benchGroupsCount)
+
+	b.ResetTimer()
+
+	for range b.N {
+		oldest := -1
+
+		var oldestSequence uint64
+
+		for i, shard := range subTasks.shards {
+			if shardSequence, exists := shard.oldestSequence(); exists && (oldest == -1 || shardSequence < oldestSequence) {
+				oldest, oldestSequence = i, shardSequence
+			}
+		}
+
+		subTasks.AddTask(subTasks.shards[oldest].evictOldestOne(oldestSequence))
+	}
+}
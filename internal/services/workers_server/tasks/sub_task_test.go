@@ -0,0 +1,167 @@
+package tasks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func neverExpiry(_ *Task) time.Time {
+	return time.Now().Add(time.Hour)
+}
+
+func TestSubTasks_PopRoundRobin(t *testing.T) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	subTasks.SetDispatchMode(DispatchModeRoundRobin)
+
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a1"})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a2"})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a3"})
+	subTasks.AddTask(&Task{GroupUuid: "b", TaskUuid: "b1"})
+	subTasks.AddTask(&Task{GroupUuid: "c", TaskUuid: "c1"})
+
+	var groups []string
+
+	for task := subTasks.Pop(); task != nil; task = subTasks.Pop() {
+		groups = append(groups, task.GroupUuid)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "a", "a"}, groups)
+}
+
+func TestSubTasks_PopTenantRoundRobin(t *testing.T) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	subTasks.SetDispatchMode(DispatchModeTenantRoundRobin)
+
+	subTasks.AddTask(&Task{GroupUuid: "a", TenantId: "x", TaskUuid: "a1"})
+	subTasks.AddTask(&Task{GroupUuid: "a", TenantId: "x", TaskUuid: "a2"})
+	subTasks.AddTask(&Task{GroupUuid: "b", TenantId: "x", TaskUuid: "b1"})
+	subTasks.AddTask(&Task{GroupUuid: "b", TenantId: "x", TaskUuid: "b2"})
+	subTasks.AddTask(&Task{GroupUuid: "c", TenantId: "y", TaskUuid: "c1"})
+	subTasks.AddTask(&Task{GroupUuid: "c", TenantId: "y", TaskUuid: "c2"})
+
+	var groups []string
+
+	for i := 0; i < 4; i++ {
+		groups = append(groups, subTasks.Pop().GroupUuid)
+	}
+
+	assert.Equal(t, []string{"a", "c", "b", "c"}, groups)
+}
+
+func TestSubTasks_EvictOldest(t *testing.T) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a1", Response: strings.Repeat("a", 10)})
+	subTasks.AddTask(&Task{GroupUuid: "b", TaskUuid: "b1", Response: strings.Repeat("b", 10)})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a2", Response: strings.Repeat("a", 10)})
+
+	assert.Equal(t, 30, subTasks.GetBytes())
+	assert.Empty(t, subTasks.EvictOldest(0))
+
+	evicted := subTasks.EvictOldest(15)
+
+	assert.Len(t, evicted, 2)
+	assert.Equal(t, "a1", evicted[0].TaskUuid)
+	assert.Equal(t, "b1", evicted[1].TaskUuid)
+	assert.Equal(t, 10, subTasks.GetBytes())
+	assert.Equal(t, 1, subTasks.GetCount())
+}
+
+func TestSubTasks_FlushRotten(t *testing.T) {
+	subTasks := NewSubTasks(func(task *Task) time.Time {
+		return time.Unix(int64(task.UnixTimeout), 0)
+	})
+
+	past := int(time.Now().Unix()) - 10
+	future := int(time.Now().Unix()) + 60
+
+	// the TTLs are per task, a long living task doesn't keep the other tasks of its group
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a1", UnixTimeout: future})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a2", UnixTimeout: past})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a3", UnixTimeout: future})
+	subTasks.AddTask(&Task{GroupUuid: "b", TaskUuid: "b1", UnixTimeout: past})
+	subTasks.AddTask(&Task{GroupUuid: "c", TaskUuid: "c1", UnixTimeout: past})
+
+	flushed := subTasks.FlushRotten()
+
+	assert.Len(t, flushed, 3)
+	assert.Equal(t, "a2", flushed[0].TaskUuid)
+	assert.Equal(t, 2, subTasks.GetCount())
+
+	assert.Empty(t, subTasks.FlushRotten())
+	assert.Equal(t, "a1", subTasks.Pop().TaskUuid)
+	assert.Equal(t, "a3", subTasks.Pop().TaskUuid)
+}
+
+func TestSubTasksListAndTakeByUuid(t *testing.T) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a1"})
+	subTasks.AddTask(&Task{GroupUuid: "b", TaskUuid: "b1"})
+	subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a2"})
+
+	listed := subTasks.ListByGroupUuid("a")
+
+	assert.Len(t, listed, 2)
+	assert.Equal(t, "a1", listed[0].TaskUuid)
+	assert.Equal(t, "a2", listed[1].TaskUuid)
+
+	assert.Nil(t, subTasks.TakeByUuid("a", "b1"))
+	assert.Equal(t, "a1", subTasks.TakeByUuid("a", "a1").TaskUuid)
+	assert.Nil(t, subTasks.TakeByUuid("a", "a1"))
+	assert.Equal(t, 2, subTasks.GetCount())
+}
+
+func TestSubTasks_PopFifoWithinGroup(t *testing.T) {
+	subTasks := NewSubTasks(neverExpiry)
+
+	for i := 0; i < 100; i++ {
+		subTasks.AddTask(&Task{GroupUuid: "a", TaskUuid: "a" + strconv.Itoa(i)})
+	}
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, "a"+strconv.Itoa(i), subTasks.Pop().TaskUuid)
+	}
+
+	assert.Equal(t, 0, subTasks.GetCount())
+}
+
+func TestShardedSubTasks_EvictOldest(t *testing.T) {
+	subTasks := NewShardedSubTasks(neverExpiry)
+
+	for i := 0; i < 10; i++ {
+		subTasks.AddTask(&Task{GroupUuid: "g" + strconv.Itoa(i), TaskUuid: "t" + strconv.Itoa(i), Response: "0123456789"})
+	}
+
+	assert.Equal(t, 100, subTasks.GetBytes())
+
+	evicted := subTasks.EvictOldest(75)
+
+	assert.Len(t, evicted, 3)
+	assert.Equal(t, "t0", evicted[0].TaskUuid)
+	assert.Equal(t, "t1", evicted[1].TaskUuid)
+	assert.Equal(t, "t2", evicted[2].TaskUuid)
+	assert.Equal(t, 7, subTasks.GetCount())
+	assert.Equal(t, "t5", subTasks.TakeFirstByGroupUuid("g5").TaskUuid)
+}
+
+func TestShardedSubTasks_EvictOldestIsBounded(t *testing.T) {
+	subTasks := NewShardedSubTasks(neverExpiry)
+
+	for i := 0; i < maxEvictedCount+10; i++ {
+		subTasks.AddTask(&Task{GroupUuid: "g" + strconv.Itoa(i), TaskUuid: "t" + strconv.Itoa(i), Response: "0"})
+	}
+
+	evicted := subTasks.EvictOldest(1)
+
+	assert.Len(t, evicted, maxEvictedCount)
+	assert.Equal(t, "t0", evicted[0].TaskUuid)
+	assert.Equal(t, "t"+strconv.Itoa(maxEvictedCount-1), evicted[maxEvictedCount-1].TaskUuid)
+
+	assert.Len(t, subTasks.EvictOldest(1), 9, "the rest is evicted by the next call")
+}
@@ -2,23 +2,93 @@ package tasks
 
 import (
 	"log/slog"
+	"sparallel_server/internal/services/workers_server/events"
+	"sparallel_server/internal/services/workers_server/payloads"
 	"sparallel_server/pkg/foundation/helpers"
 	"strconv"
+	"time"
 )
 
-func NewTasks() *Tasks {
-	return &Tasks{
-		waiting:  NewSubTasks(),
-		finished: NewSubTasks(),
+func NewTasks(dispatchMode DispatchMode, taskEvents *events.Events, packer *payloads.Packer) *Tasks {
+	tasks := &Tasks{
+		groupsWait: NewGroupsWait(),
+		retention:  NewGroupsRetention(),
+		latencies:  NewLatencies(),
+		events:     taskEvents,
 	}
+
+	// the waiting tasks are not sharded, the dispatch order spans all groups and there is one dispatcher:
+	// a sharded pop has to peek every shard (see BenchmarkShardedSubTasks_PopFifo against BenchmarkSubTasks_PopFifo)
+	tasks.waiting = NewSubTasks(tasks.waitingExpiry)
+	tasks.finished = NewShardedSubTasks(tasks.finishedExpiry)
+	tasks.dependencies = NewDependencies(tasks.finished, packer)
+
+	tasks.waitingTtl.Store(5)
+
+	tasks.waiting.SetDispatchMode(dispatchMode)
+
+	return tasks
+}
+
+func (t *Tasks) SetRetention(waitingTtl int, resultTtl int, finishedMaxBytes int) {
+	t.waitingTtl.Store(int64(waitingTtl))
+	t.resultTtl.Store(int64(resultTtl))
+	t.finishedMaxBytes.Store(int64(finishedMaxBytes))
+}
+
+func (t *Tasks) SetGroupRetention(groupUuid string, waitingTtl int, resultTtl int) bool {
+	return t.retention.Set(groupUuid, waitingTtl, resultTtl)
+}
+
+func (t *Tasks) OnFinished(listener func(task *Task)) {
+	t.finishedListeners = append(t.finishedListeners, listener)
+}
+
+func (t *Tasks) OnWaiting(listener func()) {
+	t.waitingListeners = append(t.waitingListeners, listener)
+}
+
+func (t *Tasks) SetDispatchMode(dispatchMode DispatchMode) {
+	t.waiting.SetDispatchMode(dispatchMode)
 }
 
 func (t *Tasks) AddWaiting(task *Task) {
 	slog.Debug("Task [" + task.TaskUuid + "] waiting")
 
+	task.EnqueuedAt = time.Now()
+
+	t.dependencies.AddPending(task)
+
 	t.waiting.AddTask(task)
 
+	t.addEvent(task, events.TypeAdded, "")
+
 	helpers.IncInt64Async(&t.addedTotalCount)
+
+	t.notifyWaiting()
+}
+
+// AddPending makes the task known to its dependents before it is added to the waiting tasks asynchronously
+func (t *Tasks) AddPending(task *Task) {
+	t.dependencies.AddPending(task)
+}
+
+func (t *Tasks) AddDependent(task *Task) error {
+	slog.Debug("Task [" + task.TaskUuid + "] holding for dependencies")
+
+	resolution, err := t.dependencies.Hold(task)
+
+	if err != nil {
+		return err
+	}
+
+	t.addEvent(task, events.TypeAdded, "holding for dependencies")
+
+	helpers.IncInt64Async(&t.addedTotalCount)
+
+	t.release(resolution)
+
+	return nil
 }
 
 func (t *Tasks) ReAddWaiting(task *Task) {
@@ -26,11 +96,15 @@ func (t *Tasks) ReAddWaiting(task *Task) {
 
 	t.waiting.AddTask(task)
 
+	t.addEvent(task, events.TypeReAdded, "")
+
 	helpers.IncInt64Async(&t.reAddedTotalCount)
+
+	t.notifyWaiting()
 }
 
-func (t *Tasks) TakeWaiting() *Task {
-	task := t.waiting.Pop()
+func (t *Tasks) TakeWaiting(allow func(task *Task) bool) *Task {
+	task := t.waiting.PopAllowed(allow)
 
 	if task == nil {
 		return nil
@@ -43,50 +117,235 @@ func (t *Tasks) TakeWaiting() *Task {
 	return task
 }
 
+func (t *Tasks) Dispatched(task *Task) {
+	task.DispatchedAt = time.Now()
+
+	wait := task.DispatchedAt.Sub(task.EnqueuedAt)
+
+	t.groupsWait.Add(task.GroupUuid, wait)
+	t.latencies.queueWait.Add(wait)
+}
+
 func (t *Tasks) AddFinished(task *Task) {
 	slog.Debug("Task [" + task.TaskUuid + "] finished")
 
-	t.finished.AddTask(task)
+	task.FinishedAt = time.Now()
+
+	payloads.Release(task.Payload, task.PayloadEncoding)
+
+	task.Payload = ""
+	task.PayloadEncoding = payloads.EncodingPlain
+
+	if !task.DispatchedAt.IsZero() {
+		t.latencies.execution.Add(task.FinishedAt.Sub(task.DispatchedAt))
+	}
+
+	finished := *task
+
+	// resolving makes the task collectable, so it is copied for the listeners before
+	resolution := t.dependencies.Resolve(task)
+
+	t.evict(t.finished.EvictOldest(int(t.finishedMaxBytes.Load())))
+
+	for _, listener := range t.finishedListeners {
+		listener(&finished)
+	}
 
 	helpers.IncInt64Async(&t.finishedTotalCount)
 
+	if task.TimedOut {
+		helpers.IncInt64Async(&t.timeoutTotalCount)
+	}
+
 	if task.IsError {
 		helpers.IncInt64Async(&t.errorTotalCount)
 	} else {
 		helpers.IncInt64Async(&t.successTotalCount)
 	}
+
+	t.release(resolution)
 }
 
 func (t *Tasks) TakeFinished(groupUuid string) *Task {
-	return t.finished.TakeFirstByGroupUuid(groupUuid)
-}
+	task := t.finished.TakeFirstByGroupUuid(groupUuid)
 
-func (t *Tasks) FlushRottenTasks() {
-	var deletedCount int
+	if task == nil {
+		return nil
+	}
 
-	deletedCount = t.waiting.FlushFirstRotten()
+	task.CollectedAt = time.Now()
 
-	if deletedCount > 0 {
-		slog.Debug("Flushed rotten waiting tasks: " + strconv.Itoa(deletedCount))
+	t.addEvent(task, events.TypeCollected, "")
 
-		helpers.IncInt64AsyncDelta(&t.timeoutTotalCount, deletedCount)
-	}
+	t.latencies.collection.Add(task.CollectedAt.Sub(task.FinishedAt))
 
-	deletedCount = t.finished.FlushFirstRotten()
+	return task
+}
 
-	if deletedCount > 0 {
-		slog.Debug("Flushed rotten finished tasks: " + strconv.Itoa(deletedCount))
+func (t *Tasks) Acknowledge(groupUuid string, taskUuid string, via string) bool {
+	task := t.finished.TakeByUuid(groupUuid, taskUuid)
 
-		helpers.IncInt64AsyncDelta(&t.timeoutTotalCount, deletedCount)
+	if task == nil {
+		return false
 	}
+
+	task.CollectedAt = time.Now()
+
+	t.addEvent(task, events.TypeDelivered, via)
+
+	t.latencies.collection.Add(task.CollectedAt.Sub(task.FinishedAt))
+
+	release(task)
+
+	return true
+}
+
+func (t *Tasks) ListFinished(groupUuid string) []*Task {
+	return t.finished.ListByGroupUuid(groupUuid)
+}
+
+func (t *Tasks) FlushRottenTasks() {
+	flushed := t.waiting.FlushRotten()
+
+	t.dependencies.Forget(flushed)
+
+	t.flush("waiting", flushed)
+	t.flush("finished", t.finished.FlushRotten())
+	t.flush("holding", t.dependencies.FlushRotten())
+
+	t.groupsWait.FlushStale(time.Minute)
+	t.retention.FlushStale(time.Now())
 }
 
 func (t *Tasks) DeleteGroup(groupUuid string) {
-	t.waiting.DeleteGroup(groupUuid)
-	t.finished.DeleteGroup(groupUuid)
+	var deleted []*Task
+
+	deleted = append(deleted, t.waiting.DeleteGroup(groupUuid)...)
+	deleted = append(deleted, t.finished.DeleteGroup(groupUuid)...)
+	deleted = append(deleted, t.dependencies.DeleteGroup(groupUuid)...)
+
+	t.retention.Delete(groupUuid)
+
+	for _, task := range deleted {
+		t.addEvent(task, events.TypeCancelled, "")
+
+		release(task)
+	}
 }
 
 func (t *Tasks) DeleteTask(task *Task) {
+	t.dependencies.Forget([]*Task{task})
 	t.waiting.DeleteTask(task)
 	t.finished.DeleteTask(task)
 }
+
+func (t *Tasks) release(resolution *DependenciesResolution) {
+	for _, task := range resolution.Ready {
+		slog.Debug("Task [" + task.TaskUuid + "] dependencies succeeded")
+
+		task.EnqueuedAt = time.Now()
+
+		t.waiting.AddTask(task)
+	}
+
+	if len(resolution.Ready) > 0 {
+		t.notifyWaiting()
+	}
+
+	for _, task := range resolution.Failed {
+		slog.Debug("Task [" + task.TaskUuid + "] dependencies failed")
+
+		t.addEvent(task, events.TypeError, task.Response)
+
+		t.AddFinished(task)
+	}
+}
+
+func (t *Tasks) notifyWaiting() {
+	for _, listener := range t.waitingListeners {
+		listener()
+	}
+}
+
+func (t *Tasks) AddEvent(task *Task, eventType events.Type, pid int, message string) {
+	t.events.Add(task.GroupUuid, task.TaskUuid, eventType, pid, message)
+}
+
+func (t *Tasks) GetEvents(taskUuid string) []events.Event {
+	return t.events.Get(taskUuid)
+}
+
+func (t *Tasks) addEvent(task *Task, eventType events.Type, message string) {
+	t.AddEvent(task, eventType, 0, message)
+}
+
+func (t *Tasks) flush(name string, flushed []*Task) {
+	if len(flushed) == 0 {
+		return
+	}
+
+	slog.Debug("Flushed rotten " + name + " tasks: " + strconv.Itoa(len(flushed)))
+
+	for _, task := range flushed {
+		t.addEvent(task, events.TypeFlushed, name)
+
+		release(task)
+	}
+
+	helpers.IncInt64AsyncDelta(&t.timeoutTotalCount, len(flushed))
+}
+
+func release(task *Task) {
+	payloads.Release(task.Payload, task.PayloadEncoding)
+	payloads.Release(task.Response, task.ResponseEncoding)
+}
+
+func (t *Tasks) evict(evicted []*Task) {
+	if len(evicted) == 0 {
+		return
+	}
+
+	slog.Debug("Evicted oldest finished tasks: " + strconv.Itoa(len(evicted)))
+
+	for _, task := range evicted {
+		t.addEvent(task, events.TypeEvicted, "")
+
+		release(task)
+	}
+
+	helpers.IncInt64AsyncDelta(&t.evictedTotalCount, len(evicted))
+}
+
+func (t *Tasks) waitingExpiry(task *Task) time.Time {
+	ttl, _ := t.retention.Get(task.GroupUuid)
+
+	if ttl <= 0 {
+		ttl = int(t.waitingTtl.Load())
+	}
+
+	expiresAt := time.Unix(int64(task.UnixTimeout+ttl), 0)
+
+	t.retention.Keep(task.GroupUuid, expiresAt)
+
+	return expiresAt
+}
+
+func (t *Tasks) finishedExpiry(task *Task) time.Time {
+	_, ttl := t.retention.Get(task.GroupUuid)
+
+	if ttl <= 0 {
+		ttl = int(t.resultTtl.Load())
+	}
+
+	var expiresAt time.Time
+
+	if ttl <= 0 {
+		expiresAt = deadline(task.UnixTimeout, 5)
+	} else {
+		expiresAt = task.FinishedAt.Add(time.Duration(ttl) * time.Second)
+	}
+
+	t.retention.Keep(task.GroupUuid, expiresAt)
+
+	return expiresAt
+}
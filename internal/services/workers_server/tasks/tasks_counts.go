@@ -8,6 +8,14 @@ func (t *Tasks) GetFinishedCount() int {
 	return t.finished.GetCount()
 }
 
+func (t *Tasks) GetFinishedBytes() int {
+	return t.finished.GetBytes()
+}
+
+func (t *Tasks) GetHoldingCount() int {
+	return t.dependencies.GetHoldingCount()
+}
+
 func (t *Tasks) GetAddedTotalCount() int {
 	return int(t.addedTotalCount.Load())
 }
@@ -34,3 +42,15 @@ func (t *Tasks) GetErrorTotalCount() int {
 func (t *Tasks) GetTimeoutTotalCount() int {
 	return int(t.timeoutTotalCount.Load())
 }
+
+func (t *Tasks) GetEvictedTotalCount() int {
+	return int(t.evictedTotalCount.Load())
+}
+
+func (t *Tasks) GetGroupsWaitStats() map[string]GroupWaitStats {
+	return t.groupsWait.Stats()
+}
+
+func (t *Tasks) GetLatenciesStats() LatenciesStats {
+	return t.latencies.Stats()
+}
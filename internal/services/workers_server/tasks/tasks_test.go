@@ -0,0 +1,111 @@
+package tasks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sparallel_server/internal/services/workers_server/events"
+	"sparallel_server/internal/services/workers_server/payloads"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTasks_FinishedExpiry(t *testing.T) {
+	taskEvents, _ := events.NewEvents(10, "")
+
+	tasks := NewTasks(DispatchModeFifo, taskEvents, payloads.NewPacker(0, 0, t.TempDir()))
+
+	finishedAt := time.Now()
+	unixTimeout := int(finishedAt.Unix()) + 60
+
+	task := &Task{GroupUuid: "g", UnixTimeout: unixTimeout, FinishedAt: finishedAt}
+
+	assert.Equal(t, time.Unix(int64(unixTimeout)+5, 0), tasks.finishedExpiry(task), "kept until the timeout by default")
+
+	tasks.SetRetention(5, 30, 0)
+
+	assert.Equal(t, finishedAt.Add(30*time.Second), tasks.finishedExpiry(task))
+
+	assert.True(t, tasks.SetGroupRetention("g", 0, 10))
+	assert.False(t, tasks.SetGroupRetention("g", 0, 20), "the overrides are set once")
+
+	assert.Equal(t, finishedAt.Add(10*time.Second), tasks.finishedExpiry(task))
+	assert.Equal(t, finishedAt.Add(30*time.Second), tasks.finishedExpiry(&Task{GroupUuid: "other", FinishedAt: finishedAt}))
+}
+
+func TestTasks_GroupRetentionFlushesWaiting(t *testing.T) {
+	taskEvents, _ := events.NewEvents(10, "")
+
+	tasks := NewTasks(DispatchModeFifo, taskEvents, payloads.NewPacker(0, 0, t.TempDir()))
+
+	tasks.SetRetention(60, 0, 0)
+
+	unixTimeout := int(time.Now().Unix()) - 10
+
+	tasks.SetGroupRetention("short", 1, 0)
+
+	tasks.AddWaiting(&Task{GroupUuid: "short", TaskUuid: "s", UnixTimeout: unixTimeout})
+	tasks.AddWaiting(&Task{GroupUuid: "default", TaskUuid: "d", UnixTimeout: unixTimeout})
+
+	tasks.FlushRottenTasks()
+
+	assert.Equal(t, 1, tasks.GetWaitingCount())
+	assert.Equal(t, "d", tasks.TakeWaiting(nil).TaskUuid)
+
+	tasks.DeleteGroup("short")
+
+	assert.True(t, tasks.SetGroupRetention("short", 5, 0), "the overrides are dropped with the group")
+}
+
+func TestTasks_FinishedBytesCountSpillFiles(t *testing.T) {
+	taskEvents, _ := events.NewEvents(10, "")
+
+	tasks := NewTasks(DispatchModeFifo, taskEvents, payloads.NewPacker(0, 0, t.TempDir()))
+
+	tasks.SetRetention(5, 0, 150)
+
+	packer := payloads.NewPacker(0, 50, t.TempDir())
+
+	unixTimeout := int(time.Now().Unix()) + 60
+
+	for _, taskUuid := range []string{"1", "2"} {
+		response, encoding, err := packer.Pack(strings.Repeat("r", 100))
+
+		assert.NoError(t, err)
+		assert.Equal(t, payloads.EncodingSpill, encoding)
+
+		tasks.AddFinished(&Task{
+			GroupUuid:        "g",
+			TaskUuid:         taskUuid,
+			UnixTimeout:      unixTimeout,
+			IsFinished:       true,
+			Response:         response,
+			ResponseEncoding: encoding,
+		})
+	}
+
+	assert.Equal(t, 100, tasks.GetFinishedBytes())
+	assert.Equal(t, 1, tasks.GetFinishedCount())
+	assert.Eventually(t, func() bool {
+		return tasks.GetEvictedTotalCount() == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTasks_ReAddWaitingNotifies(t *testing.T) {
+	taskEvents, _ := events.NewEvents(10, "")
+
+	tasks := NewTasks(DispatchModeFifo, taskEvents, payloads.NewPacker(0, 0, t.TempDir()))
+
+	notified := 0
+
+	tasks.OnWaiting(func() {
+		notified++
+	})
+
+	task := &Task{GroupUuid: "g", TaskUuid: "t", UnixTimeout: int(time.Now().Unix()) + 60}
+
+	tasks.AddWaiting(task)
+	tasks.ReAddWaiting(tasks.TakeWaiting(nil))
+
+	assert.Equal(t, 2, notified)
+	assert.Equal(t, 1, tasks.GetWaitingCount())
+}
@@ -18,6 +18,8 @@ type Workers struct {
 	// map[WorkerUuid]
 	busy map[string]*Worker
 
+	affinity map[string]string
+
 	totalCount atomic.Int64
 	busyCount  atomic.Int64
 	freeCount  atomic.Int64
@@ -35,8 +37,19 @@ type Worker struct {
 	process *processes.Process
 	task    *tasks.Task
 	reload  bool
+	tags    map[string]bool
 }
 
 func (w *Worker) GetProcess() *processes.Process {
 	return w.process
 }
+
+func (w *Worker) HasTags(tags []string) bool {
+	for _, tag := range tags {
+		if !w.tags[tag] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -12,13 +12,14 @@ import (
 
 func NewWorkers() *Workers {
 	return &Workers{
-		pw:   make(map[string]*Worker),
-		free: make(map[string]*Worker),
-		busy: make(map[string]*Worker),
+		pw:       make(map[string]*Worker),
+		free:     make(map[string]*Worker),
+		busy:     make(map[string]*Worker),
+		affinity: make(map[string]string),
 	}
 }
 
-func (w *Workers) Add(process *processes.Process) {
+func (w *Workers) Add(process *processes.Process, tags []string) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
@@ -27,6 +28,11 @@ func (w *Workers) Add(process *processes.Process) {
 	newWorker := &Worker{
 		uuid:    workerUuid,
 		process: process,
+		tags:    make(map[string]bool, len(tags)),
+	}
+
+	for _, tag := range tags {
+		newWorker.tags[tag] = true
 	}
 
 	w.pw[process.Uuid] = newWorker
@@ -47,20 +53,18 @@ func (w *Workers) Take(task *tasks.Task) *Worker {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	var selectedWorker *Worker
+	selectedWorker := w.selectFree(task)
 
-	for _, worker := range w.free {
-		delete(w.free, worker.uuid)
+	if selectedWorker == nil {
+		return nil
+	}
 
-		w.freeCount.Add(-1)
+	delete(w.free, selectedWorker.uuid)
 
-		selectedWorker = worker
+	w.freeCount.Add(-1)
 
-		break
-	}
-
-	if selectedWorker == nil {
-		return nil
+	if task.RoutingKey != "" {
+		w.affinity[task.RoutingKey] = selectedWorker.uuid
 	}
 
 	selectedWorker.task = task
@@ -74,6 +78,17 @@ func (w *Workers) Take(task *tasks.Task) *Worker {
 	return selectedWorker
 }
 
+func (w *Workers) HasFree(task *tasks.Task) bool {
+	if w.freeCount.Load() == 0 || w.closing.Load() {
+		return false
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.selectFree(task) != nil
+}
+
 func (w *Workers) Free(worker *Worker) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
@@ -193,6 +208,7 @@ func (w *Workers) Close() error {
 	w.pw = make(map[string]*Worker)
 	w.free = make(map[string]*Worker)
 	w.busy = make(map[string]*Worker)
+	w.affinity = make(map[string]string)
 
 	return nil
 }
@@ -218,9 +234,33 @@ func (w *Workers) deleteByProcessUuid(processUuid string) *processes.Process {
 
 	delete(w.pw, processUuid)
 
+	for routingKey, workerUuid := range w.affinity {
+		if workerUuid == worker.uuid {
+			delete(w.affinity, routingKey)
+		}
+	}
+
 	w.totalCount.Add(-1)
 
 	helpers.IncInt64Async(&w.deletedCount)
 
 	return worker.process
 }
+
+func (w *Workers) selectFree(task *tasks.Task) *Worker {
+	if task.RoutingKey != "" {
+		if workerUuid, exists := w.affinity[task.RoutingKey]; exists {
+			if worker, isFree := w.free[workerUuid]; isFree && worker.HasTags(task.RequiredTags) {
+				return worker
+			}
+		}
+	}
+
+	for _, worker := range w.free {
+		if worker.HasTags(task.RequiredTags) {
+			return worker
+		}
+	}
+
+	return nil
+}
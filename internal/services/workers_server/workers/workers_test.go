@@ -0,0 +1,33 @@
+package workers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sparallel_server/internal/services/workers_server/processes"
+	"sparallel_server/internal/services/workers_server/tasks"
+	"testing"
+)
+
+func TestWorkers_TakeByTagsAndRoutingKey(t *testing.T) {
+	workers := NewWorkers()
+
+	workers.Add(&processes.Process{Uuid: "plain"}, nil)
+	workers.Add(&processes.Process{Uuid: "gd-1"}, []string{"gd"})
+	workers.Add(&processes.Process{Uuid: "gd-2"}, []string{"gd", "imagick"})
+
+	worker := workers.Take(&tasks.Task{RequiredTags: []string{"imagick"}, RoutingKey: "tenant"})
+
+	assert.NotNil(t, worker)
+	assert.Equal(t, "gd-2", worker.GetProcess().Uuid)
+
+	workers.Free(worker)
+
+	for i := 0; i < 10; i++ {
+		worker = workers.Take(&tasks.Task{RequiredTags: []string{"gd"}, RoutingKey: "tenant"})
+
+		assert.Equal(t, "gd-2", worker.GetProcess().Uuid)
+
+		workers.Free(worker)
+	}
+
+	assert.Nil(t, workers.Take(&tasks.Task{RequiredTags: []string{"unknown"}}))
+}
@@ -49,6 +49,8 @@ func (a *App) Start(commandName string, args []string) {
 		}
 	}(a)
 
+	a.registerProviders()
+
 	if commandName == "" {
 		fmt.Println("Commands:")
 
@@ -69,14 +71,6 @@ func (a *App) Start(commandName string, args []string) {
 
 	a.addRunningCommand(command)
 
-	for _, provider := range a.serviceProviders {
-		err := provider.Register()
-
-		if err != nil {
-			panic(err)
-		}
-	}
-
 	signals := make(chan os.Signal, 3)
 
 	defer signal.Stop(signals)
@@ -117,9 +111,21 @@ func (a *App) Start(commandName string, args []string) {
 		}
 	}()
 
+	ctx := context.Background()
+
+	for _, hooks := range GetRegistry().GetHooks() {
+		if hooks.OnStart == nil {
+			continue
+		}
+
+		if err := hooks.OnStart(ctx); err != nil {
+			panic(errs.Err(err))
+		}
+	}
+
 	filteredArgs := a.filterArgs(args)
 
-	err := command.Handle(context.Background(), filteredArgs)
+	err := command.Handle(ctx, filteredArgs)
 
 	if err != nil {
 		panic(err)
@@ -139,6 +145,16 @@ func (a *App) Pause() error {
 		}
 	}
 
+	for _, hooks := range GetRegistry().GetHooks() {
+		if hooks.OnPause == nil {
+			continue
+		}
+
+		if err := hooks.OnPause(); err != nil {
+			return errs.Err(err)
+		}
+	}
+
 	return nil
 }
 
@@ -153,6 +169,16 @@ func (a *App) UnPause() error {
 		}
 	}
 
+	for _, hooks := range GetRegistry().GetHooks() {
+		if hooks.OnUnPause == nil {
+			continue
+		}
+
+		if err := hooks.OnUnPause(); err != nil {
+			return errs.Err(err)
+		}
+	}
+
 	return nil
 }
 
@@ -167,6 +193,18 @@ func (a *App) Close() error {
 		}
 	}
 
+	hooks := GetRegistry().GetHooks()
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if hooks[i].OnClose == nil {
+			continue
+		}
+
+		if err := hooks[i].OnClose(); err != nil {
+			return errs.Err(err)
+		}
+	}
+
 	for _, listener := range a.lastCloseListeners {
 		err := listener.Close()
 
@@ -178,6 +216,26 @@ func (a *App) Close() error {
 	return nil
 }
 
+func (a *App) registerProviders() {
+	registry := GetRegistry()
+
+	for _, provider := range a.serviceProviders {
+		err := provider.Register(registry)
+
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for name, command := range registry.GetCommands() {
+		if _, exists := a.commands[name]; exists {
+			panic(errs.Err(errors.New("command [" + name + "] is already registered")))
+		}
+
+		a.commands[name] = command
+	}
+}
+
 func (a *App) addRunningCommand(listener commands.CommandInterface) {
 	a.runningCommands = append(a.runningCommands, listener)
 }
@@ -1,5 +1,5 @@
 package app
 
 type ServiceProviderInterface interface {
-	Register() error
+	Register(registry *Registry) error
 }
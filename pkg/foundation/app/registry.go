@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sparallel_server/pkg/foundation/app_io"
+	"sparallel_server/pkg/foundation/commands"
+	"sparallel_server/pkg/foundation/errs"
+	"sync"
+)
+
+var registry *Registry
+var registryOnce sync.Once
+
+type RpcServerInterface interface {
+	io.Closer
+	app_io.Pauser
+}
+
+type RpcServerFactory func(ctx context.Context) RpcServerInterface
+
+type StatsSection struct {
+	Name    string
+	Collect func() any
+}
+
+type LifecycleHooks struct {
+	OnStart   func(ctx context.Context) error
+	OnPause   func() error
+	OnUnPause func() error
+	OnClose   func() error
+}
+
+type Registry struct {
+	mutex         sync.Mutex
+	commands      map[string]commands.CommandInterface
+	rpcServers    []RpcServerFactory
+	statsSections []StatsSection
+	hooks         []LifecycleHooks
+}
+
+func GetRegistry() *Registry {
+	registryOnce.Do(func() {
+		registry = newRegistry()
+	})
+
+	return registry
+}
+
+func newRegistry() *Registry {
+	return &Registry{
+		commands: make(map[string]commands.CommandInterface),
+	}
+}
+
+func (r *Registry) AddCommand(name string, command commands.CommandInterface) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.commands[name]; exists {
+		return errs.Err(errors.New("command [" + name + "] is already registered"))
+	}
+
+	r.commands[name] = command
+
+	return nil
+}
+
+func (r *Registry) AddRpcServer(factory RpcServerFactory) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rpcServers = append(r.rpcServers, factory)
+}
+
+func (r *Registry) AddStatsSection(name string, collect func() any) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, section := range r.statsSections {
+		if section.Name == name {
+			return errs.Err(errors.New("stats section [" + name + "] is already registered"))
+		}
+	}
+
+	r.statsSections = append(r.statsSections, StatsSection{Name: name, Collect: collect})
+
+	return nil
+}
+
+func (r *Registry) AddHooks(hooks LifecycleHooks) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.hooks = append(r.hooks, hooks)
+}
+
+func (r *Registry) GetCommands() map[string]commands.CommandInterface {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	result := make(map[string]commands.CommandInterface, len(r.commands))
+
+	for name, command := range r.commands {
+		result[name] = command
+	}
+
+	return result
+}
+
+func (r *Registry) GetRpcServers() []RpcServerFactory {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]RpcServerFactory(nil), r.rpcServers...)
+}
+
+func (r *Registry) GetStatsSections() []StatsSection {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]StatsSection(nil), r.statsSections...)
+}
+
+func (r *Registry) GetHooks() []LifecycleHooks {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]LifecycleHooks(nil), r.hooks...)
+}
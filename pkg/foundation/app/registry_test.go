@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testProvider struct {
+}
+
+func (p *testProvider) Register(registry *Registry) error {
+	registry.AddRpcServer(func(ctx context.Context) RpcServerInterface {
+		return nil
+	})
+
+	return registry.AddStatsSection("test", func() any {
+		return 1
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	registry := newRegistry()
+
+	assert.NoError(t, (&testProvider{}).Register(registry))
+
+	assert.Len(t, registry.GetRpcServers(), 1)
+	assert.Len(t, registry.GetStatsSections(), 1)
+
+	assert.Error(t, (&testProvider{}).Register(registry), "stats section is registered twice")
+	assert.Len(t, registry.GetStatsSections(), 1)
+
+	assert.NoError(t, registry.AddCommand("test", nil))
+	assert.Error(t, registry.AddCommand("test", nil))
+	assert.Len(t, registry.GetCommands(), 1)
+}